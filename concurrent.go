@@ -0,0 +1,62 @@
+package iblt
+
+import (
+    "sync"
+)
+
+var DEFAULT_LOCK_STRIPES = 64
+
+// Table safe for concurrent Insert and Delete from many goroutines.
+// Bucket updates commute, so every bucket is updated under its own
+// stripe lock without holding the others. Stop inserting before handing
+// Table to Subtract, Decode or Serialize.
+type ConcurrentTable struct {
+    table *Table
+    locks []sync.Mutex
+}
+
+func NewConcurrentTable(t *Table) *ConcurrentTable {
+    stripes := DEFAULT_LOCK_STRIPES
+    if uint(stripes) > t.BktNum {
+        stripes = int(t.BktNum)
+    }
+    return &ConcurrentTable{
+        table: t,
+        locks: make([]sync.Mutex, stripes),
+    }
+}
+
+// The underlying table, not safe to use while inserts are in flight
+func (c *ConcurrentTable) Table() *Table {
+    return c.table
+}
+
+func (c *ConcurrentTable) Insert(d []byte) error {
+    return c.operate(d, true)
+}
+
+func (c *ConcurrentTable) Delete(d []byte) error {
+    return c.operate(d, false)
+}
+
+func (c *ConcurrentTable) operate(d []byte, sign bool) error {
+    d, err := c.table.item(d)
+    if err != nil {
+        return err
+    }
+    var buf [maxStackHashNum]uint
+    idx, err := c.table.index(d, buf[:0])
+    if err != nil {
+        return err
+    }
+
+    h := c.table.Key.sipHash(d)
+    for _, i := range idx {
+        lock := &c.locks[i%uint(len(c.locks))]
+        lock.Lock()
+        c.table.operateBucket(i, d, h[:], sign)
+        lock.Unlock()
+    }
+
+    return nil
+}
@@ -0,0 +1,71 @@
+package iblt
+
+import (
+    "math/rand"
+    "reflect"
+    "sync"
+    "testing"
+)
+
+// run with -race
+func TestConcurrentTable(t *testing.T) {
+    workers := 8
+    itemsPerWorker := 2000
+    items := make([][]byte, workers*itemsPerWorker)
+    for i := range items {
+        items[i] = make([]byte, 6)
+        rand.Read(items[i])
+    }
+
+    expected := NewTable(1024, 6, 3, 4)
+    for i, b := range items {
+        var err error
+        // every third item is deleted, exercising both signs
+        if i % 3 == 0 {
+            err = expected.Delete(b)
+        } else {
+            err = expected.Insert(b)
+        }
+        if err != nil {
+            t.Errorf("operate failed error: %v", err)
+        }
+    }
+
+    table := NewConcurrentTable(NewTable(1024, 6, 3, 4))
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w ++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            // interleave workers over the same buckets
+            for i := w; i < len(items); i += workers {
+                var err error
+                if i % 3 == 0 {
+                    err = table.Delete(items[i])
+                } else {
+                    err = table.Insert(items[i])
+                }
+                if err != nil {
+                    t.Errorf("operate failed error: %v", err)
+                }
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    if !reflect.DeepEqual(table.Table(), expected) {
+        t.Error("concurrent table not equal to sequential table")
+    }
+}
+
+func BenchmarkConcurrentTable_Insert(b *testing.B) {
+    table := NewConcurrentTable(New(1000))
+    b.RunParallel(func(pb *testing.PB) {
+        d := make([]byte, DEFAULT_DATA_BYTES)
+        rand.Read(d)
+        for i := 0; pb.Next(); i ++ {
+            d[0] = byte(i)
+            table.Insert(d)
+        }
+    })
+}
@@ -0,0 +1,55 @@
+package iblt
+
+import (
+    "errors"
+    "fmt"
+)
+
+// Sentinel errors, returned wrapped with context, test with errors.Is
+var (
+    // inserted or deleted item is not DataLen long
+    ErrDataLength = errors.New("data length mismatch")
+    // operands were built with different parameters
+    ErrParamMismatch = errors.New("table parameters mismatch")
+    // table was built with parameters it cannot operate with
+    ErrBadParams = errors.New("illegal table parameters")
+    // a bucket count does not fit in CountLen bytes
+    ErrCountOverflow = errors.New("bucket count overflows count length")
+    // peeling could not start or stalled
+    ErrNoPureBucket = errors.New("no pure buckets in table")
+    // peeling finished with non-empty buckets
+    ErrDirtyTable = errors.New("dirty entries remained")
+    // the same item was recovered on both sides, a false pure bucket was peeled
+    ErrDuplicateInDiff = errors.New("repetitive bytes found in diff")
+    // serialized input is truncated, corrupted or illegal
+    ErrMalformed = errors.New("malformed serialized data")
+    // two items share a short ID, or an item was inserted twice
+    ErrShortIDCollision = errors.New("short ID collision")
+    // deleted item was never inserted into the short ID table
+    ErrUnknownItem = errors.New("item not indexed")
+)
+
+// Returned by Decode when peeling stalls or turns out inconsistent,
+// the Diff returned alongside holds everything recovered so far.
+// Callers could accept the partial result, or retry with a larger table.
+// MultisetTable.Decode and KVTable.ListEntries return it as well
+type DecodeError struct {
+    // one of ErrNoPureBucket, ErrDirtyTable or ErrDuplicateInDiff, possibly wrapped
+    Err       error
+    // number of items in the partial Diff, or entries listed
+    Recovered int
+    // number of non-empty buckets left after peeling
+    Remaining int
+    // the table after peeling, Recovered items are already removed,
+    // nil unless a Table was decoded
+    Residual  *Table
+}
+
+func (e *DecodeError) Error() string {
+    return fmt.Sprintf("%v: %d items recovered, %d non-empty buckets remained",
+        e.Err, e.Recovered, e.Remaining)
+}
+
+func (e *DecodeError) Unwrap() error {
+    return e.Err
+}
@@ -0,0 +1,84 @@
+package iblt
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+    table := NewTable(80, 6, 3, 4)
+    if err := table.Insert(make([]byte, 5)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("insert want ErrDataLength, get %v", err)
+    }
+    if err := table.Delete(make([]byte, 7)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("delete want ErrDataLength, get %v", err)
+    }
+
+    for _, other := range []*Table{
+        NewTable(81, 6, 3, 4),
+        NewTable(80, 7, 3, 4),
+        NewTable(80, 6, 2, 4),
+        NewTable(80, 6, 3, 5),
+        NewTableWithKey(80, 6, 3, 4, SipKey{K0: 1, K1: 2}),
+    } {
+        if err := table.Subtract(other); !errors.Is(err, ErrParamMismatch) {
+            t.Errorf("subtract want ErrParamMismatch, get %v", err)
+        }
+    }
+
+    overflow := NewTable(80, 6, 3, 4)
+    overflow.CountLen = 1
+    for i := 0; i < 128; i ++ {
+        overflow.Insert(make([]byte, 6))
+    }
+    if _, err := overflow.Serialize(); !errors.Is(err, ErrCountOverflow) {
+        t.Errorf("serialize want ErrCountOverflow, get %v", err)
+    }
+    overflow.CountLen = 3
+    if _, err := overflow.Serialize(); !errors.Is(err, ErrBadParams) {
+        t.Errorf("serialize want ErrBadParams, get %v", err)
+    }
+
+    for _, bad := range []*Table{
+        NewTable(8, 6, 3, 0),
+        NewTable(8, 6, 3, 9),
+        NewPartitionedTable(8, 6, 3, 0),
+        NewPartitionedTable(8, 6, 3, 3),
+    } {
+        if err := bad.Insert(make([]byte, 6)); !errors.Is(err, ErrBadParams) {
+            t.Errorf("insert want ErrBadParams, get %v", err)
+        }
+    }
+
+    if _, err := Deserialize([]byte("not a table")); !errors.Is(err, ErrMalformed) {
+        t.Errorf("deserialize want ErrMalformed, get %v", err)
+    }
+
+    for i := 0; i < 100; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    _, err := table.Decode()
+    if !errors.Is(err, ErrNoPureBucket) && !errors.Is(err, ErrDirtyTable) && !errors.Is(err, ErrDuplicateInDiff) {
+        t.Errorf("overloaded decode want a decode sentinel, get %v", err)
+    }
+}
+
+func TestDiffDuplicate(t *testing.T) {
+    bkt := NewBucket(6, 3)
+    rand.Read(bkt.dataSum)
+    diff := NewDiff(80)
+
+    bkt.count = 1
+    if err := diff.encode(bkt); err != nil {
+        t.Errorf("encode failed error: %v", err)
+    }
+    bkt.count = -1
+    if err := diff.encode(bkt); !errors.Is(err, ErrDuplicateInDiff) {
+        t.Errorf("encode want ErrDuplicateInDiff, get %v", err)
+    }
+}
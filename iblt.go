@@ -1,41 +1,136 @@
 package iblt
 
 import (
-    "bytes"
-    "encoding/binary"
-    "errors"
+    "fmt"
     "github.com/dchest/siphash"
-    "github.com/golang-collections/collections/queue"
     "github.com/willf/bitset"
     "math"
+    "math/bits"
+    "sort"
 )
 
 var DEFAULT_DATA_BYTES = 6
 var DEFAULT_HASH_BYTES = 3
+var DEFAULT_COUNT_BYTES = 2
+var DEFAULT_KEY = SipKey{K0: key0, K1: key1}
+
+// index scratch lives on the stack up to this many hash functions
+const maxStackHashNum = 16
 
 type Table struct {
     BktNum  uint
     DataLen int
     HashLen int
     HashNum int
-    buckets []*Bucket
-    bitsSet *bitset.BitSet
+    Key     SipKey
+    // serialized width (in byte) of bucket count, either 1, 2, 4, 8, or 0 for varint
+    CountLen int
+    // split buckets into HashNum equal sub-tables, one per hash function,
+    // set before any insert
+    Partitioned bool
+    // items are length-prefixed and zero-padded to DataLen, so any item
+    // up to MaxItemLen bytes could be inserted, set before any insert
+    VariableLen bool
+    // bucket i spans dataSums[i*DataLen:(i+1)*DataLen], hashSums likewise
+    dataSums []byte
+    hashSums []byte
+    counts   []int64
+}
+
+type IbltParam struct {
+    NumHashFuncs int
+    ItemOverhead float64
+}
+
+// ibltParamMap in iblt_params.go is simulated against the real Table by internal/paramgen
+//go:generate go run ./internal/paramgen -max 1000 -dense 100 -step 10 -o iblt_params.go
+
+// item counts in ibltParamMap, ascending
+var ibltParamCounts = sortedParamCounts()
+
+func sortedParamCounts() []uint {
+    counts := make([]uint, 0, len(ibltParamMap))
+    for n := range ibltParamMap {
+        counts = append(counts, n)
+    }
+    sort.Slice(counts, func(i, j int) bool { return counts[i] < counts[j] })
+    return counts
 }
 
+// Simulated parameters for numItems, interpolated between simulated counts.
+// Beyond the largest one, overhead shrinks towards the peeling threshold
+// with the square root of numItems
 func GetIbltParams(numItems uint) IbltParam {
     ibltParam, present := ibltParamMap[numItems]
-    if !present {
-        ibltParam = IbltParam{NumHashFuncs: 4, ItemOverhead: 1.36}
+    if present {
+        return ibltParam
+    }
+    if len(ibltParamCounts) == 0 {
+        return IbltParam{NumHashFuncs: 4, ItemOverhead: 1.36}
+    }
+
+    i := sort.Search(len(ibltParamCounts), func(i int) bool { return ibltParamCounts[i] > numItems })
+    if i == 0 {
+        return ibltParamMap[ibltParamCounts[0]]
+    }
+    lo := ibltParamCounts[i-1]
+    loParam := ibltParamMap[lo]
+    if i < len(ibltParamCounts) {
+        hi := ibltParamCounts[i]
+        hiParam := ibltParamMap[hi]
+        frac := float64(numItems-lo) / float64(hi-lo)
+        ibltParam = IbltParam{
+            NumHashFuncs: loParam.NumHashFuncs,
+            ItemOverhead: loParam.ItemOverhead + frac*(hiParam.ItemOverhead-loParam.ItemOverhead),
+        }
+        if frac > 0.5 {
+            ibltParam.NumHashFuncs = hiParam.NumHashFuncs
+        }
+        return ibltParam
+    }
+
+    // finite size excess over the threshold decays as 1/sqrt(n)
+    limit := 1 / peelingThreshold(loParam.NumHashFuncs)
+    excess := loParam.ItemOverhead - limit
+    if excess < 0 {
+        excess = 0
+    }
+    return IbltParam{
+        NumHashFuncs: loParam.NumHashFuncs,
+        ItemOverhead: limit + excess*math.Sqrt(float64(lo)/float64(numItems)),
     }
+}
 
-    return ibltParam
+// Load factor (items per cell) below which peeling k-uniform hypergraphs
+// succeeds as the number of items grows, min over x of x / (k(1-e^-x)^(k-1))
+func peelingThreshold(k int) float64 {
+    f := func(x float64) float64 {
+        return x / (float64(k) * math.Pow(-math.Expm1(-x), float64(k-1)))
+    }
+    if k < 3 {
+        // minimum at x -> 0
+        return f(1e-9)
+    }
+    // unimodal, golden section
+    a, b := 1e-9, 10.0
+    phi := (math.Sqrt(5) - 1) / 2
+    for b-a > 1e-9 {
+        c, d := b-phi*(b-a), a+phi*(b-a)
+        if f(c) < f(d) {
+            b = d
+        } else {
+            a = c
+        }
+    }
+    return f((a + b) / 2)
 }
 
 func GetCellCount(numItems uint) uint {
     ibltParam := GetIbltParams(numItems)
     numCells := uint(math.Ceil(float64(numItems) * ibltParam.ItemOverhead))
 
-    for uint(ibltParam.NumHashFuncs) * numCells / uint(ibltParam.NumHashFuncs) != numCells {
+    // multiple of number of hash functions, so that the table could be partitioned
+    for numCells % uint(ibltParam.NumHashFuncs) != 0 {
         numCells += 1
     }
 
@@ -49,19 +144,48 @@ func New(numItems uint) *Table {
     return NewTable(numCells, DEFAULT_DATA_BYTES, DEFAULT_HASH_BYTES, ibltParam.NumHashFuncs)
 }
 
+// Same as New, but partitioned
+func NewPartitioned(numItems uint) *Table {
+    table := New(numItems)
+    table.Partitioned = true
+    return table
+}
+
 // Specify number of buckets, data field length (in byte), number of hash functions
 func NewTable(buckets uint, dataLen int, hashLen int, hashNum int, ) *Table {
+    return NewTableWithKey(buckets, dataLen, hashLen, hashNum, DEFAULT_KEY)
+}
+
+// Same as NewTable, but hashes with a caller-supplied SipHash key,
+// both parties of a reconciliation must agree on the key
+func NewTableWithKey(buckets uint, dataLen int, hashLen int, hashNum int, key SipKey) *Table {
     return &Table{
         BktNum:  buckets,
         DataLen: dataLen,
         HashLen: hashLen,
         HashNum: hashNum,
-        buckets: make([]*Bucket, buckets),
-        bitsSet: bitset.New(buckets),
+        Key:     key,
+        CountLen: DEFAULT_COUNT_BYTES,
+        dataSums: make([]byte, buckets*uint(dataLen)),
+        hashSums: make([]byte, buckets*uint(hashLen)),
+        counts:   make([]int64, buckets),
     }
 }
 
+// Same as NewTable, but each hash function maps into its own sub-table of
+// buckets/hashNum buckets, which guarantees distinct buckets in one pass,
+// buckets must be a multiple of hashNum
+func NewPartitionedTable(buckets uint, dataLen int, hashLen int, hashNum int) *Table {
+    table := NewTable(buckets, dataLen, hashLen, hashNum)
+    table.Partitioned = true
+    return table
+}
+
 func (t *Table) Insert(d []byte) error {
+    d, err := t.item(d)
+    if err != nil {
+        return err
+    }
     if err := t.operate(d, true); err != nil {
         return err
     }
@@ -70,6 +194,10 @@ func (t *Table) Insert(d []byte) error {
 }
 
 func (t *Table) Delete(d []byte) error {
+    d, err := t.item(d)
+    if err != nil {
+        return err
+    }
     if err := t.operate(d, false); err != nil {
         return err
     }
@@ -77,59 +205,137 @@ func (t *Table) Delete(d []byte) error {
     return nil
 }
 
+// d must not alias the table
 func (t *Table) operate(d []byte, sign bool) error {
-    cpy := make([]byte, len(d))
-    copy(cpy, d)
-    err := t.index(cpy)
+    var buf [maxStackHashNum]uint
+    idx, err := t.index(d, buf[:0])
     if err != nil {
         return err
     }
 
-    for i, e := t.bitsSet.NextSet(0); e; i, e = t.bitsSet.NextSet(i + 1) {
-        t.operateBucket(i, cpy, sign)
+    h := t.Key.sipHash(d)
+    for _, i := range idx {
+        t.operateBucket(i, d, h[:], sign)
     }
 
     return nil
 }
 
-func (t *Table) index(d []byte) error {
+// appends the HashNum distinct bucket indexes of d to idx
+func (t Table) index(d []byte, idx []uint) ([]uint, error) {
     if len(d) != t.DataLen {
-        return errors.New("insert byte length mismatches base data length")
+        return idx, fmt.Errorf("%w: want %d, get %d", ErrDataLength, t.DataLen, len(d))
     }
 
-    if t.bitsSet == nil {
-        t.bitsSet = bitset.New(t.BktNum)
+    if t.HashNum < 1 || uint(t.HashNum) > t.BktNum {
+        return idx, fmt.Errorf("%w: %d hash functions for %d buckets", ErrBadParams, t.HashNum, t.BktNum)
     }
 
-    t.bitsSet.ClearAll()
-    tries := 1
-    for i := 0; i < t.HashNum; {
-        // assume we can always find different keys
-        // as this is in high probability
-        h := siphash.Hash(key0, uint64(key1+tries), d)
-        tries++
-        // TODO: modulo produces imbalanced uniform distribution
-        idx := uint(h) % t.BktNum
-        if !t.bitsSet.Test(idx) {
-            t.bitsSet.Set(idx)
-            i++
+    // size of each sub-table, divided once per item rather than per hash function
+    part := uint(0)
+    if t.Partitioned {
+        if t.BktNum % uint(t.HashNum) != 0 {
+            return idx, fmt.Errorf("%w: partitioned bucket number %d is not a multiple of %d", ErrBadParams, t.BktNum, t.HashNum)
         }
+        part = t.BktNum / uint(t.HashNum)
     }
 
-    return nil
+    // all locations derive from one 128-bit hash, the i-th from h1 + i*h2,
+    // mixed so that two items sharing one location rarely share the others
+    h1, h2 := siphash.Hash128(t.Key.K0, t.Key.K1, d)
+    start := len(idx)
+    for i := 0; i < t.HashNum; i++ {
+        h := mix(h1 + uint64(i)*h2)
+        if t.Partitioned {
+            idx = append(idx, uint(i)*part+reduce(h, part))
+            continue
+        }
+        next := reduce(h, t.BktNum)
+        // step to the next free bucket on collision,
+        // bounded since there are at least HashNum buckets
+        for contains(idx[start:], next) {
+            next++
+            if next == t.BktNum {
+                next = 0
+            }
+        }
+        idx = append(idx, next)
+    }
+
+    return idx, nil
 }
 
-func (t Table) Copy() *Table {
-    rtn := NewTable(t.BktNum, t.DataLen, t.HashLen, t.HashNum)
-    for i, bkt := range t.buckets {
-        if bkt != nil {
-            rtn.buckets[i] = bkt.copy()
+func contains(idx []uint, i uint) bool {
+    for _, v := range idx {
+        if v == i {
+            return true
         }
     }
+    return false
+}
 
+// SplitMix64 finalizer, a bijection with full avalanche
+func mix(h uint64) uint64 {
+    h = (h ^ (h >> 30)) * 0xbf58476d1ce4e5b9
+    h = (h ^ (h >> 27)) * 0x94d049bb133111eb
+    return h ^ (h >> 31)
+}
+
+// maps h to [0, n) fairly without division, Lemire's multiply-shift
+func reduce(h uint64, n uint) uint {
+    hi, _ := bits.Mul64(h, uint64(n))
+    return uint(hi)
+}
+
+func (t Table) Copy() *Table {
+    rtn := t.emptyCopy()
+    copy(rtn.dataSums, t.dataSums)
+    copy(rtn.hashSums, t.hashSums)
+    copy(rtn.counts, t.counts)
+
+    return rtn
+}
+
+// same parameters, no buckets
+func (t Table) emptyCopy() *Table {
+    rtn := NewTableWithKey(t.BktNum, t.DataLen, t.HashLen, t.HashNum, t.Key)
+    rtn.CountLen = t.CountLen
+    rtn.Partitioned = t.Partitioned
+    rtn.VariableLen = t.VariableLen
     return rtn
 }
 
+// Modify callee, t = t + a, the union when a was built over a disjoint set
+func (t *Table) Add(a *Table) error {
+    err := t.check(a)
+    if err != nil {
+        return err
+    }
+
+    xor(t.dataSums, a.dataSums)
+    xor(t.hashSums, a.hashSums)
+    for i := range t.counts {
+        t.counts[i] += a.counts[i]
+    }
+
+    return nil
+}
+
+// Modify callee, t = t + tables[0] + tables[1] + ...
+// t is untouched if any of tables mismatches
+func (t *Table) Merge(tables ...*Table) error {
+    for n, a := range tables {
+        if err := t.check(a); err != nil {
+            return fmt.Errorf("table %d: %w", n, err)
+        }
+    }
+    for _, a := range tables {
+        t.Add(a)
+    }
+
+    return nil
+}
+
 // Modify callee, t = t - a
 func (t *Table) Subtract(a *Table) error {
     err := t.check(a)
@@ -137,53 +343,64 @@ func (t *Table) Subtract(a *Table) error {
         return err
     }
 
-    for i := range t.buckets {
-        if t.buckets[i] != nil && a.buckets[i] != nil {
-            t.buckets[i].subtract(a.buckets[i])
-        }
-        if t.buckets[i] == nil && a.buckets[i] != nil {
-            t.buckets[i] = a.buckets[i].copy()
-            t.buckets[i].count = -t.buckets[i].count
-        }
+    xor(t.dataSums, a.dataSums)
+    xor(t.hashSums, a.hashSums)
+    for i := range t.counts {
+        t.counts[i] -= a.counts[i]
     }
 
     return nil
 }
 
-// Decode is self-destructive
+// Decode on a scratch copy, t is left intact and could be decoded again
+func (t Table) DecodeCopy() (*Diff, error) {
+    return t.Copy().Decode()
+}
+
+// Decode is self-destructive, use DecodeCopy to keep the table
 func (t *Table) Decode() (*Diff, error) {
     diff := NewDiff(t.BktNum)
     if t.empty() {
         return diff, nil
     }
 
-    pure := queue.New()
-    err := t.enqueuePure(pure)
+    // scratch reused across rounds
+    pure := make([]uint, 0)
+    pureMask := bitset.New(t.BktNum)
+    idx := make([]uint, 0, t.HashNum)
+    d := make([]byte, t.DataLen)
+
+    pure, err := t.enqueuePure(pure, pureMask, idx)
     if err != nil {
         return diff, err
     }
     // ensure we have at least one pure bucket in the IBLT
     // this is necessary condition for decoding an IBLT
-    if pure.Len() == 0 {
-        return diff, errors.New("no pure buckets in table")
+    if len(pure) == 0 {
+        return diff, t.decodeError(ErrNoPureBucket, diff)
     }
 
-    bkt := NewBucket(t.DataLen, t.HashLen)
-    for pure.Len() > 0 {
+    for len(pure) > 0 {
         // clean out pure queue, delete all pure buckets and output the stored data
         // it will create more pure buckets to decode in the next cycle
-        for pure.Len() > 0 {
-            bkt = pure.Dequeue().(*Bucket)
-            if err = diff.encode(bkt); err != nil {
-                return diff, nil
+        for _, i := range pure {
+            bkt := t.bucket(i)
+            // bucket is about to be cleared by its own data
+            copy(d, bkt.dataSum)
+            if t.VariableLen {
+                // validated by enqueuePure
+                bkt.dataSum, _ = t.unpad(d)
+            }
+            if err = diff.encode(&bkt); err != nil {
+                return diff, t.decodeError(err, diff)
             }
             // Insert if count < 0, Delete if count > 0
-            if err = t.operate(bkt.dataSum, bkt.count < 0); err != nil {
+            if err = t.operate(d, bkt.count < 0); err != nil {
                 return diff, err
             }
         }
         // now pure queue should be empty, enqueue more pure cell
-        err = t.enqueuePure(pure)
+        pure, err = t.enqueuePure(pure[:0], pureMask, idx)
         if err != nil {
             return diff, err
         }
@@ -193,111 +410,119 @@ func (t *Table) Decode() (*Diff, error) {
     }
     // check if every bucket is empty
     if !t.empty() {
-        return diff, errors.New("dirty entries remained")
+        return diff, t.decodeError(ErrDirtyTable, diff)
     }
 
     return diff, nil
 }
 
+// t is what is left after peeling
+func (t *Table) decodeError(err error, diff *Diff) *DecodeError {
+    remaining := 0
+    for i := uint(0); i < t.BktNum; i++ {
+        if !t.bucket(i).empty() {
+            remaining++
+        }
+    }
+    return &DecodeError{
+        Err:       err,
+        Recovered: diff.AlphaLen() + diff.BetaLen(),
+        Remaining: remaining,
+        Residual:  t,
+    }
+}
+
 func (t Table) empty() bool {
-    for i := range t.buckets {
-        if t.buckets[i] != nil && !t.buckets[i].empty() {
+    for _, c := range t.counts {
+        if c != 0 {
             return false
         }
     }
-    return true
+    return empty(t.dataSums) && empty(t.hashSums)
 }
 
-func (t *Table) enqueuePure(pure *queue.Queue) error {
-    // TODO: mark empty bucket and skip early
-    pureMask := bitset.New(t.bitsSet.Len())
-    for i := range t.buckets {
+// appends pure bucket indexes to pure, pureMask and idx are scratch
+func (t *Table) enqueuePure(pure []uint, pureMask *bitset.BitSet, idx []uint) ([]uint, error) {
+    pureMask.ClearAll()
+    for i := uint(0); i < t.BktNum; i++ {
         // skip the same pure bucket at difference indexes, enqueue the first one
-        if t.buckets[i] != nil && !pureMask.Test(uint(i)) && t.buckets[i].pure() {
-            if err := t.index(t.buckets[i].dataSum); err != nil {
-                return err
+        if (t.counts[i] == 1 || t.counts[i] == -1) && !pureMask.Test(i) && t.bucket(i).pure(t.Key) {
+            var err error
+            if idx, err = t.index(t.bucket(i).dataSum, idx[:0]); err != nil {
+                return pure, err
             }
-            if !t.bitsSet.Test(uint(i)) {
+            if !contains(idx, i) {
                 // current bucket is a false pure
                 continue
             }
-            pureMask.InPlaceUnion(t.bitsSet)
-            pure.Enqueue(t.buckets[i])
+            if t.VariableLen {
+                if _, ok := t.unpad(t.bucket(i).dataSum); !ok {
+                    // garbage rather than a padded item, false pure as well
+                    continue
+                }
+            }
+            for _, j := range idx {
+                pureMask.Set(j)
+            }
+            pure = append(pure, i)
         }
     }
-    return nil
+    return pure, nil
 }
 
 func (t Table) check(a *Table) error {
     if t.BktNum != a.BktNum {
-        return errors.New("subtract table mismatches bucket number")
+        return fmt.Errorf("%w: bucket number %d, %d", ErrParamMismatch, t.BktNum, a.BktNum)
     }
 
     if t.DataLen != a.DataLen {
-        return errors.New("subtract table mismatches data length")
+        return fmt.Errorf("%w: data length %d, %d", ErrParamMismatch, t.DataLen, a.DataLen)
     }
 
     if t.HashLen != a.HashLen {
-        return errors.New("subtract table mismatches hash length")
+        return fmt.Errorf("%w: hash length %d, %d", ErrParamMismatch, t.HashLen, a.HashLen)
     }
 
     if t.HashNum != a.HashNum {
-        return errors.New("subtract table mismatches number of hash functions")
+        return fmt.Errorf("%w: number of hash functions %d, %d", ErrParamMismatch, t.HashNum, a.HashNum)
     }
 
-    if len(t.buckets) != len(a.buckets) {
-        return errors.New("illegally appended buckets")
+    if t.Key != a.Key {
+        return fmt.Errorf("%w: hash key", ErrParamMismatch)
     }
 
-    return nil
-}
-
-func (t *Table) operateBucket(idx uint, d []byte, sign bool) {
-    if t.buckets[idx] == nil {
-        t.buckets[idx] = NewBucket(t.DataLen, t.HashLen)
+    if t.Partitioned != a.Partitioned {
+        return fmt.Errorf("%w: partitioned", ErrParamMismatch)
     }
-    t.buckets[idx].operate(d, sign)
-}
 
-func (t Table) Serialize() ([]byte, error) {
-    var buffer bytes.Buffer
-    twoBytes := make([]byte, 2)
+    if t.VariableLen != a.VariableLen {
+        return fmt.Errorf("%w: variable length", ErrParamMismatch)
+    }
 
-    for _, unsigned := range []uint16{uint16(t.BktNum), uint16(t.DataLen), uint16(t.HashLen), uint16(t.HashNum),} {
-        binary.BigEndian.PutUint16(twoBytes, uint16(unsigned))
-        buffer.Write(twoBytes)
+    if len(t.counts) != len(a.counts) || len(t.dataSums) != len(a.dataSums) || len(t.hashSums) != len(a.hashSums) {
+        return fmt.Errorf("%w: illegally appended buckets", ErrParamMismatch)
     }
 
-    for idx, bkt := range t.buckets {
-        if bkt != nil && !bkt.empty() {
-            binary.BigEndian.PutUint16(twoBytes, uint16(idx))
-            buffer.Write(twoBytes)
-            binary.BigEndian.PutUint16(twoBytes, uint16(bkt.count))
-            buffer.Write(twoBytes)
+    return nil
+}
 
-            buffer.Write(bkt.dataSum)
-            buffer.Write(bkt.hashSum)
-        }
+// view into the flat storage, dataSum and hashSum alias the table
+func (t Table) bucket(idx uint) Bucket {
+    return Bucket{
+        dataSum: t.dataSums[idx*uint(t.DataLen) : (idx+1)*uint(t.DataLen)],
+        hashSum: t.hashSums[idx*uint(t.HashLen) : (idx+1)*uint(t.HashLen)],
+        count:   int(t.counts[idx]),
     }
-    return buffer.Bytes(), nil
 }
 
-func Deserialize(b []byte) (*Table, error) {
-    reader := bytes.NewBuffer(b)
-
-    bktNum := uint(binary.BigEndian.Uint16(reader.Next(2)))
-    dataLen := int(binary.BigEndian.Uint16(reader.Next(2)))
-    hashLen := int(binary.BigEndian.Uint16(reader.Next(2)))
-    hashNum := int(binary.BigEndian.Uint16(reader.Next(2)))
-
-    table := NewTable(bktNum, dataLen, hashLen, hashNum)
-    for next := reader.Next(2); len(next) != 0; next = reader.Next(2) {
-        idx := binary.BigEndian.Uint16(next)
-        table.buckets[idx] = NewBucket(dataLen, hashLen)
-        table.buckets[idx].count = int(int16(binary.BigEndian.Uint16(reader.Next(2))))
-        copy(table.buckets[idx].dataSum, reader.Next(dataLen))
-        copy(table.buckets[idx].hashSum, reader.Next(hashLen))
+// h is the sipHash of d
+func (t *Table) operateBucket(idx uint, d []byte, h []byte, sign bool) {
+    bkt := t.bucket(idx)
+    xor(bkt.dataSum, d)
+    xor(bkt.hashSum, h)
+    if sign {
+        t.counts[idx]++
+    } else {
+        t.counts[idx]--
     }
-
-    return table, nil
 }
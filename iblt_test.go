@@ -2,8 +2,13 @@ package iblt
 
 import (
     "bytes"
+    "encoding/binary"
+    "errors"
+    "hash/crc32"
+    "math"
     "math/rand"
     "reflect"
+    "runtime"
     "sort"
     "testing"
     "time"
@@ -193,24 +198,16 @@ func TestTableEncodeDecode(t *testing.T) {
         if rec.HashNum != cpy.HashNum {
             t.Errorf("recoveried hashNum not equal, want %v, get %v", cpy.HashNum, rec.HashNum)
         }
-        for idx, bkt := range rec.buckets {
-            cpyBkt := cpy.buckets[idx]
-            if bkt == nil && cpyBkt != nil {
-                t.Errorf("recoveried bucket is nil at %d, want %v", idx, cpyBkt)
+        for idx := uint(0); idx < cpy.BktNum; idx++ {
+            bkt, cpyBkt := rec.bucket(idx), cpy.bucket(idx)
+            if bkt.count != cpyBkt.count {
+                t.Errorf("recoveried bucket count not equal at %d, want %v, get %v", idx, cpyBkt.count, bkt.count)
             }
-            if bkt != nil && cpyBkt == nil {
-                t.Errorf("recoveried bucket is not nil at %d, get %v", idx, bkt)
+            if !bytes.Equal(bkt.dataSum, cpyBkt.dataSum) {
+                t.Errorf("recoveried bucket dataSum not equal at %d, want, %v, get %v", idx, cpyBkt.dataSum, bkt.dataSum)
             }
-            if bkt != nil && cpyBkt != nil {
-                if bkt.count != cpyBkt.count {
-                    t.Errorf("recoveried bucket count not equal at %d, want %v, get %v", idx, cpyBkt.count, bkt.count)
-                }
-                if !bytes.Equal(bkt.dataSum, cpyBkt.dataSum) {
-                    t.Errorf("recoveried bucket dataSum not equal at %d, want, %v, get %v", idx, cpyBkt.dataSum, bkt.dataSum)
-                }
-                if !bytes.Equal(bkt.hashSum, cpyBkt.hashSum) {
-                    t.Errorf("recoveried bucket hashSum not equal at %d, want, %v, get %v", idx, cpyBkt.hashSum, bkt.hashSum)
-                }
+            if !bytes.Equal(bkt.hashSum, cpyBkt.hashSum) {
+                t.Errorf("recoveried bucket hashSum not equal at %d, want, %v, get %v", idx, cpyBkt.hashSum, bkt.hashSum)
             }
         }
         if !reflect.DeepEqual(rec, cpy) {
@@ -249,6 +246,56 @@ func TestNewTableFromNumItems(t *testing.T) {
     }
 }
 
+func TestGetIbltParams(t *testing.T) {
+    for n, param := range ibltParamMap {
+        if GetIbltParams(n) != param {
+            t.Errorf("simulated count %d should be looked up", n)
+        }
+    }
+
+    // beyond the table, overhead shrinks towards the threshold
+    last := ibltParamCounts[len(ibltParamCounts)-1]
+    prev := GetIbltParams(last)
+    for _, n := range []uint{last + 1, 2 * last, 10 * last, 1000 * last} {
+        param := GetIbltParams(n)
+        limit := 1 / peelingThreshold(param.NumHashFuncs)
+        if param.ItemOverhead > prev.ItemOverhead || param.ItemOverhead < limit {
+            t.Errorf("overhead of %d items should be within [%v, %v], get %v", n, limit, prev.ItemOverhead, param.ItemOverhead)
+        }
+        if GetCellCount(n) % uint(param.NumHashFuncs) != 0 {
+            t.Errorf("cell count of %d items should be a multiple of %d", n, param.NumHashFuncs)
+        }
+        prev = param
+    }
+
+    // between simulated counts, overhead is interpolated
+    savedMap, savedCounts := ibltParamMap, ibltParamCounts
+    defer func() { ibltParamMap, ibltParamCounts = savedMap, savedCounts }()
+    ibltParamMap = map[uint]IbltParam{
+        10: {NumHashFuncs: 5, ItemOverhead: 3},
+        20: {NumHashFuncs: 4, ItemOverhead: 2},
+    }
+    ibltParamCounts = sortedParamCounts()
+    if param := GetIbltParams(12); param.NumHashFuncs != 5 || math.Abs(param.ItemOverhead-2.8) > 1e-9 {
+        t.Errorf("interpolated params want {5 2.8}, get %v", param)
+    }
+    if param := GetIbltParams(18); param.NumHashFuncs != 4 || math.Abs(param.ItemOverhead-2.2) > 1e-9 {
+        t.Errorf("interpolated params want {4 2.2}, get %v", param)
+    }
+    if param := GetIbltParams(1); param != ibltParamMap[10] {
+        t.Errorf("count below the table should use the smallest, get %v", param)
+    }
+}
+
+func TestPeelingThreshold(t *testing.T) {
+    // Molloy, cores in random hypergraphs
+    for k, want := range map[int]float64{2: 0.5, 3: 0.818469, 4: 0.772280, 5: 0.701780} {
+        if got := peelingThreshold(k); math.Abs(got-want) > 1e-5 {
+            t.Errorf("threshold of %d hash functions want %v, get %v", k, want, got)
+        }
+    }
+}
+
 func BytesArrayToSortedString(arr [][]byte) []string {
     strArr := []string{}
     for _, subarr := range arr {
@@ -307,6 +354,52 @@ func TestSubtraction(t *testing.T) {
     }
 }
 
+func TestAddMerge(t *testing.T) {
+    numItems := 60
+    whole := New(uint(numItems))
+    shards := []*Table{New(uint(numItems)), New(uint(numItems)), New(uint(numItems))}
+    for i := 0; i < numItems; i ++ {
+        b := make([]byte, DEFAULT_DATA_BYTES)
+        rand.Read(b)
+        whole.Insert(b)
+        shards[i%len(shards)].Insert(b)
+    }
+
+    union := shards[0].Copy()
+    if err := union.Add(shards[1]); err != nil {
+        t.Errorf("add failed error: %v", err)
+    }
+    if err := union.Add(shards[2]); err != nil {
+        t.Errorf("add failed error: %v", err)
+    }
+    if !reflect.DeepEqual(union, whole) {
+        t.Error("sum of shards not equal to table of the union")
+    }
+
+    merged := New(uint(numItems))
+    if err := merged.Merge(shards...); err != nil {
+        t.Errorf("merge failed error: %v", err)
+    }
+    if !reflect.DeepEqual(merged, whole) {
+        t.Error("merged shards not equal to table of the union")
+    }
+
+    union.Subtract(shards[1])
+    union.Subtract(shards[2])
+    if !reflect.DeepEqual(union, shards[0]) {
+        t.Error("subtracting added shards should restore the table")
+    }
+
+    cpy := merged.Copy()
+    err := merged.Merge(shards[0], New(uint(numItems) * 2))
+    if !errors.Is(err, ErrParamMismatch) {
+        t.Errorf("merge of mismatched table should fail with ErrParamMismatch, get %v", err)
+    }
+    if !reflect.DeepEqual(merged, cpy) {
+        t.Error("failed merge should leave the table untouched")
+    }
+}
+
 func TestSerDe(t *testing.T) {
     numItems := 50
     var arr = [][]byte{}
@@ -350,9 +443,571 @@ func TestSerializationSize(t *testing.T) {
 
         tableBinary, _ := table1.Serialize()
 
+        // a full table is dense encoded, saving the per bucket index
         numCells := GetCellCount(uint(size))
-        if uint(len(tableBinary)) != 13*numCells + 8 {
-            t.Error("serialization size should be 13*numCells + 8 bytes")
+        if uint(len(tableBinary)) != 11*numCells + (numCells+7)/8 + 32 {
+            t.Error("serialization size should be 11*numCells + numCells/8 + 32 bytes")
+        }
+
+        var sparse bytes.Buffer
+        table1.writeTo(&sparse, layoutSparse)
+        if sparse.Len() != 12*int(numCells) + 33 {
+            t.Error("sparse serialization size should be 12*numCells + 33 bytes")
+        }
+        if len(tableBinary) >= sparse.Len() {
+            t.Error("dense serialization should be smaller than sparse for a full table")
+        }
+    }
+
+    // an almost empty table is sparse encoded
+    numCells := uint(10000)
+    table2 := NewTable(numCells, 6, 3, 4)
+    b := make([]byte, 6)
+    rand.Read(b)
+    if err := table2.Insert(b); err != nil {
+        t.Errorf("insert failed error: %v", err)
+    }
+    tableBinary, _ := table2.Serialize()
+    var dense bytes.Buffer
+    table2.writeTo(&dense, layoutDense)
+    if len(tableBinary) >= dense.Len() {
+        t.Error("sparse serialization should be smaller than dense for an almost empty table")
+    }
+}
+
+func TestTableWithKey(t *testing.T) {
+    numItems := 50
+    key := SipKey{K0: rand.Uint64(), K1: rand.Uint64()}
+    // sized the way New does, with twice the headroom, since
+    // New(numItems) itself fails to decode about 1 in 240 times
+    numCells := GetCellCount(uint(2 * numItems))
+    hashNum := GetIbltParams(uint(2 * numItems)).NumHashFuncs
+    table1 := NewTableWithKey(numCells, 6, 3, hashNum, key)
+    table2 := NewTableWithKey(numCells, 6, 3, hashNum, key)
+    table3 := NewTable(numCells, 6, 3, hashNum)
+
+    for i := 0; i < numItems; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table1.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+
+    tableBinary, _ := table1.Serialize()
+    rec, err := Deserialize(tableBinary)
+    if err != nil {
+        t.Errorf("recovery from bytes error %v", err)
+    }
+    if rec.Key != key {
+        t.Errorf("recoveried key not equal, want %v, get %v", key, rec.Key)
+    }
+
+    if err := rec.Subtract(table3); err == nil {
+        t.Error("subtract should fail with mismatched keys")
+    }
+
+    if err := rec.Subtract(table2); err != nil {
+        t.Errorf("subtract error: %v", err)
+    }
+    diff, err := rec.Decode()
+    if err != nil {
+        t.Errorf("test Decode failed error: %v", err)
+    }
+    if diff.AlphaLen() != numItems {
+        t.Errorf("output number of difference mismatch want: %d, get: %d", numItems, diff.AlphaLen())
+    }
+}
+
+func TestSerializeLargeTable(t *testing.T) {
+    numCells := uint(200000)
+    numItems := 1000
+    table := NewTable(numCells, 6, 3, 4)
+    for i := 0; i < numItems; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    cpy := table.Copy()
+
+    tableBinary, err := table.Serialize()
+    if err != nil {
+        t.Errorf("table serialize error %v", err)
+    }
+    rec, err := Deserialize(tableBinary)
+    if err != nil {
+        t.Errorf("recovery from bytes error %v", err)
+    }
+    if !reflect.DeepEqual(rec, cpy) {
+        t.Error("recoveried large IBLT not equal")
+    }
+
+    diff, err := rec.Decode()
+    if err != nil {
+        t.Errorf("test Decode failed error: %v", err)
+    }
+    if diff.AlphaLen() != numItems {
+        t.Errorf("output number of difference mismatch want: %d, get: %d", numItems, diff.AlphaLen())
+    }
+}
+
+func TestSerializeCountLen(t *testing.T) {
+    var countLens = []struct {
+        countLen int
+        overflow bool
+    }{
+        {0, false},
+        {1, true},
+        {2, false},
+        {4, false},
+        {8, false},
+    }
+
+    b := make([]byte, 6)
+    rand.Read(b)
+    for _, test := range countLens {
+        table := NewTable(80, 6, 3, 4)
+        table.CountLen = test.countLen
+        // heavy inserts and deletes into the same cells
+        for i := 0; i < 200; i ++ {
+            if err := table.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+        c := make([]byte, 6)
+        rand.Read(c)
+        for i := 0; i < 300; i ++ {
+            if err := table.Delete(c); err != nil {
+                t.Errorf("delete failed error: %v", err)
+            }
+        }
+        cpy := table.Copy()
+
+        tableBinary, err := table.Serialize()
+        if test.overflow {
+            if err == nil {
+                t.Errorf("serialize should fail on count overflow, count length: %d", test.countLen)
+            }
+            continue
+        }
+        if err != nil {
+            t.Errorf("table serialize error %v, count length: %d", err, test.countLen)
+        }
+        rec, err := Deserialize(tableBinary)
+        if err != nil {
+            t.Errorf("recovery from bytes error %v, count length: %d", err, test.countLen)
+        }
+        if !reflect.DeepEqual(rec, cpy) {
+            t.Errorf("recoveried IBLT not equal, count length: %d", test.countLen)
+        }
+    }
+
+    // counts doubled past 32 bits by adding a table to itself neither wrap
+    // in memory nor fail to round trip in 8 bytes or a varint
+    for _, countLen := range []int{0, 4, 8} {
+        table := NewTable(80, 6, 3, 4)
+        table.CountLen = countLen
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+        for i := 0; i < 40; i ++ {
+            if err := table.Add(table.Copy()); err != nil {
+                t.Errorf("add error: %v", err)
+            }
+        }
+        if size := table.Size(); size != 1 << 40 {
+            t.Errorf("size want %d, get %d", uint(1 << 40), size)
+        }
+        tableBinary, err := table.Serialize()
+        if countLen == 4 {
+            if !errors.Is(err, ErrCountOverflow) {
+                t.Errorf("serialize want ErrCountOverflow, get %v", err)
+            }
+            continue
+        }
+        if err != nil {
+            t.Errorf("table serialize error %v, count length: %d", err, countLen)
+        }
+        rec, err := Deserialize(tableBinary)
+        if err != nil {
+            t.Errorf("recovery from bytes error %v, count length: %d", err, countLen)
+        }
+        if !reflect.DeepEqual(rec, table) {
+            t.Errorf("recoveried IBLT not equal, count length: %d", countLen)
+        }
+    }
+}
+
+func TestDeserializeMalformed(t *testing.T) {
+    table := New(20)
+    for i := 0; i < 20; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    enc, err := table.Serialize()
+    if err != nil {
+        t.Errorf("table serialize error %v", err)
+    }
+
+    // every proper prefix must be rejected without panic
+    for i := 0; i < len(enc); i ++ {
+        if _, err := Deserialize(enc[:i]); err == nil {
+            t.Errorf("truncated input of %d bytes should not deserialize", i)
+        }
+    }
+
+    // every single bit flip must be rejected without panic
+    for i := 0; i < len(enc); i ++ {
+        corrupt := make([]byte, len(enc))
+        copy(corrupt, enc)
+        corrupt[i] ^= 1 << uint(rand.Intn(8))
+        if _, err := Deserialize(corrupt); err == nil {
+            t.Errorf("corrupted byte %d should not deserialize", i)
+        }
+    }
+
+    if _, err := Deserialize(append(enc, 0)); err == nil {
+        t.Error("trailing byte should not deserialize")
+    }
+
+    // well framed but illegal parameters, hashNum exceeds bktNum
+    forged := append([]byte(serialMagic), serialVersion, 4, 6, 3, 8, 2)
+    forged = append(forged, make([]byte, 16)...)
+    sum := make([]byte, 4)
+    binary.BigEndian.PutUint32(sum, crc32.ChecksumIEEE(forged))
+    if _, err := Deserialize(append(forged, sum...)); err == nil {
+        t.Error("illegal parameters should not deserialize")
+    }
+
+    // each field within its own bound, but 2^32 buckets of 2^16 bytes in total
+    forged = append([]byte(serialMagic), serialVersion)
+    for _, v := range []uint64{1 << 32, 1 << 16, 8, 4, 0, 0} {
+        forged = appendUvarint(forged, v)
+    }
+    forged = append(forged, make([]byte, 16)...)
+    forged = append(forged, layoutSparse, 0)
+    binary.BigEndian.PutUint32(sum, crc32.ChecksumIEEE(forged))
+    if _, err := Deserialize(append(forged, sum...)); !errors.Is(err, ErrMalformed) {
+        t.Errorf("huge table should fail with ErrMalformed, get %v", err)
+    }
+
+    // a legal header announcing 2^26 buckets of 8 bytes, 1 GiB in memory, must
+    // not allocate the table unless the checksum matches, nor the bitmap if it is cut short
+    for _, layout := range []byte{layoutSparse, layoutDense} {
+        forged = append([]byte(serialMagic), serialVersion)
+        for _, v := range []uint64{1 << 26, 8, 0, 4, 0, 0} {
+            forged = appendUvarint(forged, v)
+        }
+        forged = append(forged, make([]byte, 16)...)
+        forged = append(forged, layout, 0)
+        binary.BigEndian.PutUint32(sum, crc32.ChecksumIEEE(forged) ^ 1)
+        forged = append(forged, sum...)
+        var before, after runtime.MemStats
+        runtime.ReadMemStats(&before)
+        if _, err := Deserialize(forged); !errors.Is(err, ErrMalformed) {
+            t.Errorf("bad checksum should fail with ErrMalformed, get %v", err)
+        }
+        if _, err := ReadTable(bytes.NewReader(forged)); !errors.Is(err, ErrMalformed) {
+            t.Errorf("bad checksum should fail with ErrMalformed, get %v", err)
+        }
+        runtime.ReadMemStats(&after)
+        if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1 << 20 {
+            t.Errorf("forged header in layout %d allocated %d bytes", layout, allocated)
+        }
+    }
+
+    // a caller limit below the table size rejects it before allocating
+    size := uint64(table.BktNum) * uint64(table.DataLen + table.HashLen + 8)
+    if _, err := ReadTableWithLimit(bytes.NewReader(enc), size - 1); !errors.Is(err, ErrMalformed) {
+        t.Errorf("table over the limit should fail with ErrMalformed, get %v", err)
+    }
+    if _, err := ReadTableWithLimit(bytes.NewReader(enc), size); err != nil {
+        t.Errorf("table within the limit should read, get %v", err)
+    }
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+    buf := make([]byte, binary.MaxVarintLen64)
+    return append(b, buf[:binary.PutUvarint(buf, v)]...)
+}
+
+func TestTable_DecodeCopy(t *testing.T) {
+    numItems := 50
+    var arr = [][]byte{}
+    remote := New(uint(numItems))
+    for i := 0; i < numItems; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := remote.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+        arr = append(arr, b)
+    }
+    cpy := remote.Copy()
+
+    // decode the same received table against two different local sets
+    for _, shared := range []int{10, 30} {
+        local := New(uint(numItems))
+        for _, b := range arr[:shared] {
+            if err := local.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+        // subtract on a copy must leave the received table untouched
+        sub := remote.Copy()
+        if err := sub.Subtract(local); err != nil {
+            t.Errorf("subtract error: %v", err)
+        }
+        for i := 0; i < 2; i ++ {
+            diff, err := sub.DecodeCopy()
+            if err != nil {
+                t.Errorf("test Decode failed error: %v", err)
+            }
+            if diff.AlphaLen() != numItems-shared {
+                t.Errorf("output number of difference mismatch want: %d, get: %d", numItems-shared, diff.AlphaLen())
+            }
+        }
+    }
+
+    if !reflect.DeepEqual(remote, cpy) {
+        t.Error("decoded IBLT was modified")
+    }
+}
+
+func TestDecodeError(t *testing.T) {
+    numItems := 100
+    // far beyond the capacity of 80 buckets
+    table := NewTable(80, 6, 3, 4)
+    for i := 0; i < numItems; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+
+    diff, err := table.DecodeCopy()
+    var decodeErr *DecodeError
+    if !errors.As(err, &decodeErr) {
+        t.Fatalf("overloaded table should fail with DecodeError, get %v", err)
+    }
+    if decodeErr.Recovered != diff.AlphaLen() + diff.BetaLen() {
+        t.Errorf("recovered items mismatch want %d, get %d", diff.AlphaLen() + diff.BetaLen(), decodeErr.Recovered)
+    }
+    if decodeErr.Remaining == 0 || decodeErr.Residual == nil {
+        t.Error("residual table should not be empty")
+    }
+
+    // residual plus recovered items adds up to the original table
+    for _, b := range diff.AlphaSlice() {
+        if err := decodeErr.Residual.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    if err := decodeErr.Residual.Subtract(table); err != nil {
+        t.Errorf("subtract error: %v", err)
+    }
+    if !decodeErr.Residual.empty() {
+        t.Error("residual and recovered items do not add up to the table")
+    }
+}
+
+func TestIndexUniformity(t *testing.T) {
+    // odd bucket number, a modulo reduction would be biased
+    numCells := uint(999)
+    hashNum := 3
+    numItems := 100000
+
+    for _, partitioned := range []bool{false, true} {
+        table := NewTable(numCells, 6, 3, hashNum)
+        table.Partitioned = partitioned
+        hits := make([]int, numCells)
+        b := make([]byte, 6)
+        idx := make([]uint, 0, hashNum)
+        for i := 0; i < numItems; i ++ {
+            rand.Read(b)
+            var err error
+            if idx, err = table.index(b, idx[:0]); err != nil {
+                t.Errorf("index failed error: %v", err)
+            }
+            for j, i := range idx {
+                if contains(idx[:j], i) {
+                    t.Errorf("index should yield %d distinct buckets, get %v", hashNum, idx)
+                }
+                if partitioned && i / (numCells / uint(hashNum)) != uint(j) {
+                    t.Errorf("index %d of hash function %d out of its partition", i, j)
+                }
+                hits[i]++
+            }
+        }
+        chiSquaredUniform(t, hits, float64(numItems*hashNum) / float64(numCells))
+    }
+}
+
+func chiSquaredUniform(t *testing.T, hits []int, expected float64) {
+
+    // chi-squared with len(hits)-1 degrees of freedom, mean len(hits)-1 and
+    // standard deviation sqrt(2*(len(hits)-1)), reject beyond 6 deviations
+    chi2 := 0.0
+    for _, h := range hits {
+        chi2 += (float64(h) - expected) * (float64(h) - expected) / expected
+    }
+    dof := float64(len(hits) - 1)
+    if chi2 > dof + 6*math.Sqrt(2*dof) {
+        t.Errorf("bucket distribution not uniform, chi-squared %f with %v degrees of freedom", chi2, dof)
+    }
+}
+
+func TestIndexFullTable(t *testing.T) {
+    // every bucket is taken by every item, collisions must not loop
+    table := NewTable(4, 6, 3, 4)
+    b := make([]byte, 6)
+    for i := 0; i < 100; i ++ {
+        rand.Read(b)
+        idx, err := table.index(b, nil)
+        if err != nil {
+            t.Errorf("index failed error: %v", err)
+        }
+        for j := uint(0); j < 4; j ++ {
+            if !contains(idx, j) {
+                t.Error("index should take every bucket")
+            }
+        }
+    }
+
+    if err := NewTable(3, 6, 3, 4).Insert(b); err == nil {
+        t.Error("more hash functions than buckets should fail")
+    }
+    if err := NewPartitionedTable(6, 6, 3, 4).Insert(b); err == nil {
+        t.Error("partitioned bucket number not a multiple of hash functions should fail")
+    }
+}
+
+func BenchmarkTable_index(b *testing.B) {
+    table := New(1000)
+    d := make([]byte, DEFAULT_DATA_BYTES)
+    idx := make([]uint, 0, table.HashNum)
+    rand.Read(d)
+    b.ResetTimer()
+    for i := 0; i < b.N; i ++ {
+        d[0] = byte(i)
+        idx, _ = table.index(d, idx[:0])
+    }
+}
+
+func BenchmarkTable_Insert(b *testing.B) {
+    table := New(1000)
+    d := make([]byte, DEFAULT_DATA_BYTES)
+    rand.Read(d)
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i ++ {
+        d[0] = byte(i)
+        table.Insert(d)
+    }
+}
+
+func BenchmarkTable_Subtract(b *testing.B) {
+    alpha := New(1000)
+    beta := New(1000)
+    d := make([]byte, DEFAULT_DATA_BYTES)
+    for i := 0; i < 1000; i ++ {
+        rand.Read(d)
+        alpha.Insert(d)
+        rand.Read(d)
+        beta.Insert(d)
+    }
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i ++ {
+        alpha.Subtract(beta)
+    }
+}
+
+func BenchmarkTable_DecodeCopy(b *testing.B) {
+    table := New(1000)
+    d := make([]byte, DEFAULT_DATA_BYTES)
+    for i := 0; i < 500; i ++ {
+        rand.Read(d)
+        table.Insert(d)
+    }
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i ++ {
+        table.DecodeCopy()
+    }
+}
+
+func TestTableAllocs(t *testing.T) {
+    alpha := New(1000)
+    beta := New(1000)
+    d := make([]byte, DEFAULT_DATA_BYTES)
+    rand.Read(d)
+
+    if n := testing.AllocsPerRun(100, func() { alpha.Insert(d) }); n != 0 {
+        t.Errorf("Insert should not allocate, get %v allocs", n)
+    }
+    if n := testing.AllocsPerRun(100, func() { alpha.Delete(d) }); n != 0 {
+        t.Errorf("Delete should not allocate, get %v allocs", n)
+    }
+    if n := testing.AllocsPerRun(100, func() { alpha.Subtract(beta) }); n != 0 {
+        t.Errorf("Subtract should not allocate, get %v allocs", n)
+    }
+}
+
+func TestPartitionedTable(t *testing.T) {
+    // at these sizes peeling stalls now and then, partitioned or not, about as
+    // often as 1 in 40 on the smallest tables, so assert a rate over repeated trials
+    trials, allowed := 100, 10
+    for _, test := range tests {
+        failures := 0
+        for trial := 0; trial < trials; trial ++ {
+            alphaTable := NewPartitionedTable(test.bktNum, test.dataLen, test.hashLen, test.hashNum)
+            betaTable := NewPartitionedTable(test.bktNum, test.dataLen, test.hashLen, test.hashNum)
+            b := make([]byte, test.dataLen)
+            for i := 0; i < test.alphaItems; i ++ {
+                rand.Read(b)
+                if err := alphaTable.Insert(b); err != nil {
+                    t.Errorf("test Insert failed error: %v", err)
+                }
+            }
+            for i := 0; i < test.betaItems; i ++ {
+                rand.Read(b)
+                if err := betaTable.Insert(b); err != nil {
+                    t.Errorf("test Insert failed error: %v", err)
+                }
+            }
+
+            enc, err := betaTable.Serialize()
+            if err != nil {
+                t.Errorf("table serialize error %v", err)
+            }
+            rec, err := Deserialize(enc)
+            if err != nil {
+                t.Errorf("recovery from bytes error %v", err)
+            }
+            if !rec.Partitioned {
+                t.Error("recoveried table should be partitioned")
+            }
+            if err := alphaTable.Subtract(NewTable(test.bktNum, test.dataLen, test.hashLen, test.hashNum)); !errors.Is(err, ErrParamMismatch) {
+                t.Errorf("subtract unpartitioned want ErrParamMismatch, get %v", err)
+            }
+            if err := alphaTable.Subtract(rec); err != nil {
+                t.Errorf("subtract error: %v", err)
+            }
+
+            diff, err := alphaTable.Decode()
+            if err != nil || diff.AlphaLen() != test.alphaItems || diff.BetaLen() != test.betaItems {
+                failures++
+            }
+        }
+        if failures > allowed {
+            t.Errorf("decode failed %d of %d times, case: %v", failures, trials, test)
         }
     }
 }
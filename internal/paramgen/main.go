@@ -0,0 +1,178 @@
+// Command paramgen generates iblt_params.go, for every item count up to -dense
+// and every -step-th one beyond it up to -max it finds by Monte-Carlo simulation
+// against the real Table the number of hash functions and cells that decode with
+// probability -rate using the fewest cells, GetIbltParams interpolates between them.
+// The output is written to stdout unless -o names a file
+//
+//     go run ./internal/paramgen -max 1000 -dense 100 -step 10 -o iblt_params.go
+package main
+
+import (
+    "bytes"
+    "flag"
+    "fmt"
+    "go/format"
+    "hash/fnv"
+    "io/ioutil"
+    "log"
+    "math"
+    "math/rand"
+    "os"
+    "runtime"
+    "sync"
+
+    "github.com/SheldonZhong/go-IBLT"
+)
+
+var (
+    maxItems = flag.Uint("max", 1000, "largest item count in the table")
+    trials   = flag.Int("trials", 2400, "decodes simulated per configuration")
+    rate     = flag.Float64("rate", 239.0/240.0, "target decode success rate")
+    minHash  = flag.Int("minhash", 3, "fewest hash functions tried")
+    maxHash  = flag.Int("maxhash", 10, "most hash functions tried")
+    seed     = flag.Int64("seed", 1, "simulation seed, output is deterministic given the flags")
+    workers  = flag.Int("workers", runtime.NumCPU(), "item counts simulated concurrently")
+    dense    = flag.Uint("dense", 100, "largest item count simulated one by one")
+    step     = flag.Uint("step", 10, "distance between simulated item counts beyond -dense")
+    output   = flag.String("o", "-", "output file, - for stdout")
+)
+
+// peeling thresholds, load factors n/m below which decoding succeeds
+// as n grows, a good place to start the search
+var thresholds = map[int]float64{3: 0.818, 4: 0.772, 5: 0.702, 6: 0.637, 7: 0.582, 8: 0.535, 9: 0.495, 10: 0.460}
+
+func main() {
+    flag.Parse()
+    if *maxItems == 0 || *trials < 1 || *rate <= 0 || *rate > 1 || *minHash < 1 || *maxHash < *minHash || *step == 0 {
+        flag.Usage()
+        os.Exit(2)
+    }
+
+    var simulated []uint
+    for n := uint(1); n <= *maxItems; n++ {
+        if n <= *dense || n%*step == 0 || n == *maxItems {
+            simulated = append(simulated, n)
+        }
+    }
+
+    params := make([]iblt.IbltParam, *maxItems+1)
+    counts := make(chan uint)
+    var wg sync.WaitGroup
+    for w := 0; w < *workers; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for n := range counts {
+                params[n] = best(n)
+            }
+        }()
+    }
+    for _, n := range simulated {
+        counts <- n
+    }
+    close(counts)
+    wg.Wait()
+
+    var buf bytes.Buffer
+    fmt.Fprintf(&buf, "// Code generated by paramgen -max %d -dense %d -step %d -trials %d -rate %v -minhash %d -maxhash %d -seed %d; DO NOT EDIT.\n\n",
+        *maxItems, *dense, *step, *trials, *rate, *minHash, *maxHash, *seed)
+    fmt.Fprintf(&buf, "package iblt\n\nvar ibltParamMap = map[uint]IbltParam{\n")
+    for _, n := range simulated {
+        fmt.Fprintf(&buf, "%d: IbltParam{NumHashFuncs: %d, ItemOverhead: %f},\n", n, params[n].NumHashFuncs, params[n].ItemOverhead)
+    }
+    fmt.Fprintf(&buf, "}\n")
+    src, err := format.Source(buf.Bytes())
+    if err != nil {
+        log.Fatal(err)
+    }
+
+    if *output == "-" {
+        os.Stdout.Write(src)
+        return
+    }
+    if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+        log.Fatal(err)
+    }
+}
+
+// fewest cells over every number of hash functions, ties go to fewer hash functions
+func best(n uint) iblt.IbltParam {
+    bestCells, bestHash := uint(0), 0
+    for k := *minHash; k <= *maxHash; k++ {
+        cells := search(n, k)
+        if bestHash == 0 || cells < bestCells {
+            bestCells, bestHash = cells, k
+        }
+    }
+    // floored, so that GetCellCount rounds back up to exactly bestCells
+    overhead := math.Floor(float64(bestCells)/float64(n)*1e6) / 1e6
+    log.Printf("%d items: %d hash functions, %d cells", n, bestHash, bestCells)
+    return iblt.IbltParam{NumHashFuncs: bestHash, ItemOverhead: overhead}
+}
+
+// fewest cells, a multiple of k as GetCellCount rounds to, decoding n items at rate
+func search(n uint, k int) uint {
+    step := uint(k)
+    lo := roundUp(uint(math.Ceil(float64(n)*1/thresholds[k])), step)
+    if lo < step {
+        lo = step
+    }
+    // gallop up to a passing count, then bisect between the last failure and it
+    if decodes(n, k, lo) {
+        for lo > step && decodes(n, k, lo-step) {
+            lo -= step
+        }
+        return lo
+    }
+    hi := lo + step
+    for gap := step; !decodes(n, k, hi); gap *= 2 {
+        lo = hi
+        hi += gap
+    }
+    for hi-lo > step {
+        mid := roundUp((lo+hi)/2, step)
+        if mid == hi {
+            mid -= step
+        }
+        if decodes(n, k, mid) {
+            hi = mid
+        } else {
+            lo = mid
+        }
+    }
+    return hi
+}
+
+// whether n items in cells buckets with k hash functions decode at rate,
+// stops early once failures exceed what rate allows
+func decodes(n uint, k int, cells uint) bool {
+    if uint(k) > cells {
+        return false
+    }
+    h := fnv.New64a()
+    fmt.Fprintf(h, "%d/%d/%d/%d", *seed, n, k, cells)
+    rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+    allowed := int(float64(*trials) * (1 - *rate))
+    failures := 0
+    item := make([]byte, iblt.DEFAULT_DATA_BYTES)
+    for trial := 0; trial < *trials; trial++ {
+        table := iblt.NewTable(cells, iblt.DEFAULT_DATA_BYTES, iblt.DEFAULT_HASH_BYTES, k)
+        for i := uint(0); i < n; i++ {
+            rng.Read(item)
+            table.Insert(item)
+        }
+        diff, err := table.Decode()
+        if err != nil || uint(diff.AlphaLen()) != n {
+            failures++
+            if failures > allowed {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+func roundUp(v, step uint) uint {
+    return (v + step - 1) / step * step
+}
@@ -0,0 +1,288 @@
+package iblt
+
+import (
+    "bytes"
+    "fmt"
+)
+
+// Outcome of KVTable.Get
+type GetResult int
+
+const (
+    // k is certainly not in the table
+    NotFound GetResult = iota
+    // k is in the table, the value is returned
+    Found
+    // every bucket of k is shared with other keys
+    Unknown
+)
+
+func (r GetResult) String() string {
+    switch r {
+    case NotFound:
+        return "not found"
+    case Found:
+        return "found"
+    case Unknown:
+        return "unknown"
+    }
+    return fmt.Sprintf("GetResult(%d)", int(r))
+}
+
+// Key-value IBLT as in Goodrich and Mitzenmacher, keys index the buckets
+// and are checksummed, values ride along in valueSum
+type KVTable struct {
+    BktNum   uint
+    KeyLen   int
+    ValueLen int
+    HashLen  int
+    HashNum  int
+    Key      SipKey
+    // bucket i spans keySums[i*KeyLen:(i+1)*KeyLen], others likewise
+    keySums   []byte
+    valueSums []byte
+    hashSums  []byte
+    counts    []int64
+}
+
+// An entry recovered by ListEntries, Count is 1 if it was put,
+// -1 if it was removed (or only present in the subtrahend)
+type Entry struct {
+    Key   []byte
+    Value []byte
+    Count int
+}
+
+// Sized by GetCellCount to list numItems entries
+func NewKV(numItems uint, keyLen int, valueLen int) *KVTable {
+    ibltParam := GetIbltParams(numItems)
+    numCells := GetCellCount(numItems)
+
+    return NewKVTable(numCells, keyLen, valueLen, DEFAULT_HASH_BYTES, ibltParam.NumHashFuncs)
+}
+
+// Specify number of buckets, key and value field length (in byte), number of hash functions
+func NewKVTable(buckets uint, keyLen int, valueLen int, hashLen int, hashNum int) *KVTable {
+    return NewKVTableWithKey(buckets, keyLen, valueLen, hashLen, hashNum, DEFAULT_KEY)
+}
+
+// Same as NewKVTable, but hashes with a caller-supplied SipHash key
+func NewKVTableWithKey(buckets uint, keyLen int, valueLen int, hashLen int, hashNum int, key SipKey) *KVTable {
+    return &KVTable{
+        BktNum:    buckets,
+        KeyLen:    keyLen,
+        ValueLen:  valueLen,
+        HashLen:   hashLen,
+        HashNum:   hashNum,
+        Key:       key,
+        keySums:   make([]byte, buckets*uint(keyLen)),
+        valueSums: make([]byte, buckets*uint(valueLen)),
+        hashSums:  make([]byte, buckets*uint(hashLen)),
+        counts:    make([]int64, buckets),
+    }
+}
+
+func (t *KVTable) Put(k []byte, v []byte) error {
+    return t.operate(k, v, true)
+}
+
+// k, v must be a pair previously put
+func (t *KVTable) Remove(k []byte, v []byte) error {
+    return t.operate(k, v, false)
+}
+
+// k, v must not alias the table
+func (t *KVTable) operate(k []byte, v []byte, sign bool) error {
+    if len(v) != t.ValueLen {
+        return fmt.Errorf("%w: value want %d, get %d", ErrDataLength, t.ValueLen, len(v))
+    }
+    var buf [maxStackHashNum]uint
+    idx, err := t.index(k, buf[:0])
+    if err != nil {
+        return err
+    }
+
+    h := t.Key.sipHash(k)
+    for _, i := range idx {
+        bkt := t.bucket(i)
+        xor(bkt.dataSum, k)
+        xor(bkt.hashSum, h[:])
+        xor(t.value(i), v)
+        if sign {
+            t.counts[i]++
+        } else {
+            t.counts[i]--
+        }
+    }
+
+    return nil
+}
+
+// Look k up, the value is a copy and only set if Found
+func (t KVTable) Get(k []byte) ([]byte, GetResult, error) {
+    var buf [maxStackHashNum]uint
+    idx, err := t.index(k, buf[:0])
+    if err != nil {
+        return nil, Unknown, err
+    }
+
+    for _, i := range idx {
+        bkt := t.bucket(i)
+        if bkt.empty() {
+            return nil, NotFound, nil
+        }
+        if bkt.count == 1 && bkt.pure(t.Key) {
+            if !bytes.Equal(bkt.dataSum, k) {
+                // a single other key lives here
+                return nil, NotFound, nil
+            }
+            v := make([]byte, t.ValueLen)
+            copy(v, t.value(i))
+            return v, Found, nil
+        }
+    }
+
+    return nil, Unknown, nil
+}
+
+// List every entry by peeling a scratch copy, t is left intact.
+// Entries listed so far are returned along with a DecodeError of ErrDirtyTable
+// if peeling stalls, or ErrDuplicateInDiff if a key is listed twice
+func (t KVTable) ListEntries() ([]Entry, error) {
+    scratch := t.Copy()
+    entries := make([]Entry, 0)
+    listed := newByteSet(t.BktNum)
+    idx := make([]uint, 0, t.HashNum)
+    k := make([]byte, t.KeyLen)
+    v := make([]byte, t.ValueLen)
+
+    for peeled := true; peeled; {
+        peeled = false
+        for i := uint(0); i < t.BktNum; i++ {
+            bkt := scratch.bucket(i)
+            if !bkt.pure(t.Key) {
+                continue
+            }
+            var err error
+            if idx, err = scratch.index(bkt.dataSum, idx[:0]); err != nil {
+                return entries, err
+            }
+            if !contains(idx, i) {
+                // false pure
+                continue
+            }
+            if listed.test(bkt.dataSum) {
+                return entries, scratch.decodeError(fmt.Errorf("%w: key %x", ErrDuplicateInDiff, bkt.dataSum), entries)
+            }
+
+            copy(k, bkt.dataSum)
+            copy(v, scratch.value(i))
+            entries = append(entries, Entry{
+                Key:   append([]byte(nil), k...),
+                Value: append([]byte(nil), v...),
+                Count: bkt.count,
+            })
+            listed.insert(entries[len(entries)-1].Key)
+            if err = scratch.operate(k, v, bkt.count < 0); err != nil {
+                return entries, err
+            }
+            peeled = true
+        }
+    }
+
+    for i := uint(0); i < t.BktNum; i++ {
+        if !scratch.bucket(i).empty() || !empty(scratch.value(i)) {
+            return entries, scratch.decodeError(ErrDirtyTable, entries)
+        }
+    }
+
+    return entries, nil
+}
+
+// t is what is left after listing
+func (t KVTable) decodeError(err error, entries []Entry) *DecodeError {
+    remaining := 0
+    for i := uint(0); i < t.BktNum; i++ {
+        if !t.bucket(i).empty() || !empty(t.value(i)) {
+            remaining++
+        }
+    }
+    return &DecodeError{
+        Err:       err,
+        Recovered: len(entries),
+        Remaining: remaining,
+    }
+}
+
+func (t KVTable) Copy() *KVTable {
+    rtn := NewKVTableWithKey(t.BktNum, t.KeyLen, t.ValueLen, t.HashLen, t.HashNum, t.Key)
+    copy(rtn.keySums, t.keySums)
+    copy(rtn.valueSums, t.valueSums)
+    copy(rtn.hashSums, t.hashSums)
+    copy(rtn.counts, t.counts)
+
+    return rtn
+}
+
+// Modify callee, t = t - a, ListEntries then yields entries
+// only in t with Count 1 and entries only in a with Count -1.
+// A key mapped to different values on both sides cancels out
+// except for its value, and leaves the table dirty
+func (t *KVTable) Subtract(a *KVTable) error {
+    if err := t.check(a); err != nil {
+        return err
+    }
+
+    xor(t.keySums, a.keySums)
+    xor(t.valueSums, a.valueSums)
+    xor(t.hashSums, a.hashSums)
+    for i := range t.counts {
+        t.counts[i] -= a.counts[i]
+    }
+
+    return nil
+}
+
+func (t KVTable) check(a *KVTable) error {
+    keys := a.keyTable()
+    if err := t.keyTable().check(&keys); err != nil {
+        return err
+    }
+
+    if t.ValueLen != a.ValueLen {
+        return fmt.Errorf("%w: value length %d, %d", ErrParamMismatch, t.ValueLen, a.ValueLen)
+    }
+
+    if len(t.valueSums) != len(a.valueSums) {
+        return fmt.Errorf("%w: illegally appended buckets", ErrParamMismatch)
+    }
+
+    return nil
+}
+
+// the key part viewed as a plain Table, shares storage with t
+func (t KVTable) keyTable() Table {
+    return Table{
+        BktNum:   t.BktNum,
+        DataLen:  t.KeyLen,
+        HashLen:  t.HashLen,
+        HashNum:  t.HashNum,
+        Key:      t.Key,
+        dataSums: t.keySums,
+        hashSums: t.hashSums,
+        counts:   t.counts,
+    }
+}
+
+func (t KVTable) index(k []byte, idx []uint) ([]uint, error) {
+    return t.keyTable().index(k, idx)
+}
+
+// key view of bucket idx, dataSum holds the keySum
+func (t KVTable) bucket(idx uint) Bucket {
+    return t.keyTable().bucket(idx)
+}
+
+func (t KVTable) value(idx uint) []byte {
+    return t.valueSums[idx*uint(t.ValueLen) : (idx+1)*uint(t.ValueLen)]
+}
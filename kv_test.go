@@ -0,0 +1,172 @@
+package iblt
+
+import (
+    "bytes"
+    "errors"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func randomPairs(n, keyLen, valueLen int) map[string][]byte {
+    pairs := make(map[string][]byte)
+    for len(pairs) < n {
+        k := make([]byte, keyLen)
+        v := make([]byte, valueLen)
+        rand.Read(k)
+        rand.Read(v)
+        pairs[string(k)] = v
+    }
+    return pairs
+}
+
+func TestKVTable_Get(t *testing.T) {
+    pairs := randomPairs(50, 8, 16)
+    table := NewKVTable(4096, 8, 16, DEFAULT_HASH_BYTES, 4)
+    for k, v := range pairs {
+        if err := table.Put([]byte(k), v); err != nil {
+            t.Errorf("put failed error: %v", err)
+        }
+    }
+
+    for k, v := range pairs {
+        got, res, err := table.Get([]byte(k))
+        if err != nil {
+            t.Errorf("get failed error: %v", err)
+        }
+        // sparse table, every key should own at least one bucket
+        if res != Found || !bytes.Equal(got, v) {
+            t.Errorf("get want %v %v, get %v %v", Found, v, res, got)
+        }
+    }
+
+    for k := range randomPairs(50, 8, 16) {
+        if _, ok := pairs[k]; ok {
+            continue
+        }
+        if _, res, _ := table.Get([]byte(k)); res == Found {
+            t.Errorf("absent key should not be found")
+        }
+    }
+
+    for k, v := range pairs {
+        if err := table.Remove([]byte(k), v); err != nil {
+            t.Errorf("remove failed error: %v", err)
+        }
+        if _, res, _ := table.Get([]byte(k)); res != NotFound {
+            t.Errorf("removed key want %v, get %v", NotFound, res)
+        }
+    }
+
+    if _, _, err := table.Get(make([]byte, 7)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("get with wrong key length should fail with ErrDataLength, get %v", err)
+    }
+    if err := table.Put(make([]byte, 8), make([]byte, 15)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("put with wrong value length should fail with ErrDataLength, get %v", err)
+    }
+}
+
+func TestKVTable_GetUnknown(t *testing.T) {
+    // overloaded, most buckets are shared
+    table := NewKVTable(40, 8, 8, DEFAULT_HASH_BYTES, 4)
+    pairs := randomPairs(200, 8, 8)
+    for k, v := range pairs {
+        table.Put([]byte(k), v)
+    }
+    unknown := 0
+    for k, v := range pairs {
+        got, res, _ := table.Get([]byte(k))
+        if res == Unknown {
+            unknown++
+        }
+        if res == Found && !bytes.Equal(got, v) {
+            t.Errorf("found value mismatch want %v, get %v", v, got)
+        }
+    }
+    if unknown == 0 {
+        t.Error("overloaded table should answer unknown")
+    }
+}
+
+func TestKVTable_ListEntries(t *testing.T) {
+    pairs := randomPairs(100, 8, 16)
+    table := NewKV(uint(len(pairs)), 8, 16)
+    for k, v := range pairs {
+        table.Put([]byte(k), v)
+    }
+    cpy := table.Copy()
+
+    entries, err := table.ListEntries()
+    if err != nil {
+        t.Errorf("list entries failed error: %v", err)
+    }
+    if len(entries) != len(pairs) {
+        t.Errorf("number of entries want %d, get %d", len(pairs), len(entries))
+    }
+    for _, e := range entries {
+        if v, ok := pairs[string(e.Key)]; !ok || !bytes.Equal(v, e.Value) || e.Count != 1 {
+            t.Errorf("unexpected entry %v", e)
+        }
+    }
+    if !reflect.DeepEqual(table, cpy) {
+        t.Error("list entries should leave the table intact")
+    }
+
+    overloaded := NewKVTable(40, 8, 16, DEFAULT_HASH_BYTES, 4)
+    for k, v := range pairs {
+        overloaded.Put([]byte(k), v)
+    }
+    listed, err := overloaded.ListEntries()
+    if !errors.Is(err, ErrDirtyTable) {
+        t.Errorf("overloaded table should fail with ErrDirtyTable, get %v", err)
+    }
+    var decodeErr *DecodeError
+    if !errors.As(err, &decodeErr) {
+        t.Fatalf("overloaded table should fail with DecodeError, get %v", err)
+    }
+    if decodeErr.Recovered != len(listed) || decodeErr.Remaining == 0 {
+        t.Errorf("decode error mismatched, %d entries listed, get %v", len(listed), decodeErr)
+    }
+}
+
+func TestKVTable_Subtract(t *testing.T) {
+    alpha := NewKV(30, 8, 8)
+    beta := NewKV(30, 8, 8)
+    shared := randomPairs(200, 8, 8)
+    for k, v := range shared {
+        alpha.Put([]byte(k), v)
+        beta.Put([]byte(k), v)
+    }
+    onlyAlpha := randomPairs(10, 8, 8)
+    for k, v := range onlyAlpha {
+        alpha.Put([]byte(k), v)
+    }
+    onlyBeta := randomPairs(10, 8, 8)
+    for k, v := range onlyBeta {
+        beta.Put([]byte(k), v)
+    }
+
+    if err := alpha.Subtract(beta); err != nil {
+        t.Errorf("subtract failed error: %v", err)
+    }
+    entries, err := alpha.ListEntries()
+    if err != nil {
+        t.Errorf("list entries failed error: %v", err)
+    }
+    for _, e := range entries {
+        want := onlyAlpha
+        if e.Count < 0 {
+            want = onlyBeta
+        }
+        if v, ok := want[string(e.Key)]; !ok || !bytes.Equal(v, e.Value) {
+            t.Errorf("unexpected entry %v", e)
+        }
+    }
+    if len(entries) != len(onlyAlpha)+len(onlyBeta) {
+        t.Errorf("number of entries want %d, get %d", len(onlyAlpha)+len(onlyBeta), len(entries))
+    }
+
+    if err := alpha.Subtract(NewKV(30, 8, 9)); !errors.Is(err, ErrParamMismatch) {
+        t.Errorf("subtract of different value length should fail with ErrParamMismatch, get %v", err)
+    }
+}
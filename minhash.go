@@ -0,0 +1,205 @@
+package iblt
+
+import (
+    "bytes"
+    "encoding/binary"
+    "fmt"
+    "github.com/dchest/siphash"
+    "io"
+    "sort"
+)
+
+var DEFAULT_MINHASH_SIZE = 256
+var DEFAULT_HYBRID_STRATA_NUM = 16
+
+// upper bound ReadDiffEstimator accepts for the MinHash sketch size
+const maxMinHashSize = 1 << 20
+
+// Hybrid set difference estimator. A strata estimator counts small
+// differences exactly, while a bottom-k MinHash sketch estimates the
+// Jaccard similarity, and with it large differences, in constant space.
+// Items must be distinct, there is no Delete since MinHash cannot forget.
+type DiffEstimator struct {
+    Strata      *StrataEstimator
+    MinHashSize int
+    // number of inserted items
+    Count       uint64
+    // the MinHashSize smallest item hashes, ascending
+    minHashes   []uint64
+}
+
+func NewDiffEstimator(dataLen int) *DiffEstimator {
+    return newDiffEstimator(DEFAULT_MINHASH_SIZE, newStrataEstimator(DEFAULT_HYBRID_STRATA_NUM, DEFAULT_STRATA_BUCKETS,
+        dataLen, DEFAULT_HASH_BYTES, DEFAULT_STRATA_HASH_NUM, DEFAULT_KEY))
+}
+
+// Specify MinHash sketch size, and the strata parameters as in NewStrataEstimatorWithParams
+func NewDiffEstimatorWithParams(minHashSize int, strataNum int, buckets uint, dataLen int, hashLen int, hashNum int, key SipKey) (*DiffEstimator, error) {
+    if !validMinHashSize(minHashSize) {
+        return nil, fmt.Errorf("%w: MinHash size %d", ErrBadParams, minHashSize)
+    }
+    strata, err := NewStrataEstimatorWithParams(strataNum, buckets, dataLen, hashLen, hashNum, key)
+    if err != nil {
+        return nil, err
+    }
+    return newDiffEstimator(minHashSize, strata), nil
+}
+
+func newDiffEstimator(minHashSize int, strata *StrataEstimator) *DiffEstimator {
+    return &DiffEstimator{
+        Strata:      strata,
+        MinHashSize: minHashSize,
+        minHashes:   make([]uint64, 0, minHashSize),
+    }
+}
+
+func validMinHashSize(minHashSize int) bool {
+    return minHashSize >= 1 && minHashSize <= maxMinHashSize
+}
+
+func (e *DiffEstimator) Insert(d []byte) error {
+    if err := e.Strata.Insert(d); err != nil {
+        return err
+    }
+    e.Count++
+
+    key := e.Strata.Strata[0].Key
+    h := siphash.Hash(key.K1, key.K0, d)
+    i := sort.Search(len(e.minHashes), func(i int) bool { return e.minHashes[i] >= h })
+    if i < len(e.minHashes) && e.minHashes[i] == h {
+        return nil
+    }
+    if len(e.minHashes) < e.MinHashSize {
+        e.minHashes = append(e.minHashes, 0)
+    } else if i == len(e.minHashes) {
+        return nil
+    }
+    copy(e.minHashes[i+1:], e.minHashes[i:])
+    e.minHashes[i] = h
+    return nil
+}
+
+// Estimated size of the symmetric difference between the local and remote sets,
+// ready to be handed to GetCellCount or New. Neither estimator is modified.
+func EstimateDifference(local, remote *DiffEstimator) (uint, error) {
+    if local.MinHashSize != remote.MinHashSize {
+        return 0, fmt.Errorf("%w: MinHash size %d, %d", ErrParamMismatch, local.MinHashSize, remote.MinHashSize)
+    }
+    strata := local.Strata.Copy()
+    if err := strata.Subtract(remote.Strata); err != nil {
+        return 0, err
+    }
+    strataEst, exact := strata.estimate()
+    if exact {
+        return strataEst, nil
+    }
+
+    // each MinHash sample stands for about (|A|+|B|)/k items, with fewer than 16
+    // differing samples the sketch is off by over a quarter and strata is more accurate
+    total := float64(local.Count + remote.Count)
+    if float64(strataEst) < 16*total/float64(local.MinHashSize) {
+        return strataEst, nil
+    }
+
+    // |A△B| = (|A|+|B|)(1-J)/(1+J)
+    j := jaccard(local.minHashes, remote.minHashes, local.MinHashSize)
+    return uint(total*(1-j)/(1+j) + 0.5), nil
+}
+
+// Jaccard similarity estimated from the k smallest hashes of the union
+func jaccard(a, b []uint64, k int) float64 {
+    shared, union := 0, 0
+    for i, j := 0, 0; union < k && (i < len(a) || j < len(b)); union++ {
+        switch {
+        case j == len(b) || (i < len(a) && a[i] < b[j]):
+            i++
+        case i == len(a) || b[j] < a[i]:
+            j++
+        default:
+            shared++
+            i++
+            j++
+        }
+    }
+    if union == 0 {
+        return 1
+    }
+    return float64(shared) / float64(union)
+}
+
+func (e DiffEstimator) Serialize() ([]byte, error) {
+    var buffer bytes.Buffer
+    if _, err := e.WriteTo(&buffer); err != nil {
+        return nil, err
+    }
+    return buffer.Bytes(), nil
+}
+
+func DeserializeDiffEstimator(b []byte) (*DiffEstimator, error) {
+    reader := bytes.NewReader(b)
+    e, err := ReadDiffEstimator(reader)
+    if err != nil {
+        return nil, err
+    }
+    if reader.Len() != 0 {
+        return nil, fmt.Errorf("%w: trailing bytes after difference estimator", ErrMalformed)
+    }
+    return e, nil
+}
+
+// Serialize layout, all unsigned integers are uvarint encoded:
+// Count | MinHashSize | number of hashes | hashes (8 bytes each) | strata estimator
+func (e DiffEstimator) WriteTo(w io.Writer) (int64, error) {
+    tw := &tableWriter{w: w}
+    tw.writeUvarint(e.Count)
+    tw.writeUvarint(uint64(e.MinHashSize))
+    tw.writeUvarint(uint64(len(e.minHashes)))
+    for _, h := range e.minHashes {
+        tw.writeUint64(h)
+    }
+    if tw.err != nil {
+        return tw.n, tw.err
+    }
+    n, err := e.Strata.WriteTo(w)
+    return tw.n + n, err
+}
+
+func ReadDiffEstimator(r io.Reader) (*DiffEstimator, error) {
+    tr := &tableReader{r: r}
+    params := make([]uint64, 3)
+    for i := range params {
+        var err error
+        if params[i], err = binary.ReadUvarint(tr); err != nil {
+            return nil, fmt.Errorf("%w: truncated header: %v", ErrMalformed, err)
+        }
+    }
+    if params[1] > maxMinHashSize || !validMinHashSize(int(params[1])) {
+        return nil, fmt.Errorf("%w: illegal MinHash size %d", ErrMalformed, params[1])
+    }
+    if params[2] > params[1] || params[2] > params[0] {
+        return nil, fmt.Errorf("%w: illegal number of hashes %d", ErrMalformed, params[2])
+    }
+
+    minHashes := make([]uint64, params[2], params[1])
+    for i := range minHashes {
+        h, err := tr.readUint64()
+        if err != nil {
+            return nil, fmt.Errorf("%w: truncated MinHash sketch", ErrMalformed)
+        }
+        if i > 0 && h <= minHashes[i-1] {
+            return nil, fmt.Errorf("%w: MinHash sketch not ascending", ErrMalformed)
+        }
+        minHashes[i] = h
+    }
+
+    strata, err := ReadStrataEstimator(r)
+    if err != nil {
+        return nil, err
+    }
+    return &DiffEstimator{
+        Strata:      strata,
+        MinHashSize: int(params[1]),
+        Count:       params[0],
+        minHashes:   minHashes,
+    }, nil
+}
@@ -0,0 +1,93 @@
+package iblt
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+)
+
+func TestEstimateDifference(t *testing.T) {
+    var diffs = []int{0, 10, 100, 1000, 10000, 40000}
+    sharedItems := 20000
+
+    for _, d := range diffs {
+        local := NewDiffEstimator(6)
+        remote := NewDiffEstimator(6)
+        b := make([]byte, 6)
+        for i := 0; i < sharedItems; i ++ {
+            rand.Read(b)
+            if err := local.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+            if err := remote.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+        for i := 0; i < d; i ++ {
+            rand.Read(b)
+            side := local
+            if i % 2 == 0 {
+                side = remote
+            }
+            if err := side.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+
+        enc, err := remote.Serialize()
+        if err != nil {
+            t.Errorf("estimator serialize error %v", err)
+        }
+        rec, err := DeserializeDiffEstimator(enc)
+        if err != nil {
+            t.Errorf("estimator deserialize error %v", err)
+        }
+
+        est, err := EstimateDifference(local, rec)
+        if err != nil {
+            t.Errorf("estimate error %v", err)
+        }
+        if est < uint(d/2) || est > uint(2*d) {
+            t.Errorf("estimated difference off by more than a factor of 2, want %d, get %d", d, est)
+        }
+    }
+}
+
+func TestJaccard(t *testing.T) {
+    a := []uint64{1, 2, 3, 4, 5, 6}
+    b := []uint64{2, 4, 6, 8}
+    // union bottom 4 is {1, 2, 3, 4}, shared {2, 4}
+    if j := jaccard(a, b, 4); j != 0.5 {
+        t.Errorf("jaccard mismatch want 0.5, get %v", j)
+    }
+    if j := jaccard(a, a, 4); j != 1 {
+        t.Errorf("jaccard of identical sketches want 1, get %v", j)
+    }
+}
+
+func TestDiffEstimatorWithParams(t *testing.T) {
+    for _, size := range []int{-1, 0, maxMinHashSize + 1} {
+        if _, err := NewDiffEstimatorWithParams(size, 16, 80, 6, 3, 4, DEFAULT_KEY); !errors.Is(err, ErrBadParams) {
+            t.Errorf("MinHash size %d want ErrBadParams, get %v", size, err)
+        }
+    }
+
+    // every accepted estimator must survive a round trip
+    for _, size := range []int{1, 256} {
+        e, err := NewDiffEstimatorWithParams(size, 16, 80, 6, 3, 4, DEFAULT_KEY)
+        if err != nil {
+            t.Errorf("MinHash size %d error: %v", size, err)
+            continue
+        }
+        if err := e.Insert(make([]byte, 6)); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+        enc, err := e.Serialize()
+        if err != nil {
+            t.Errorf("estimator serialize error %v", err)
+        }
+        if _, err := DeserializeDiffEstimator(enc); err != nil {
+            t.Errorf("MinHash size %d deserialize error %v", size, err)
+        }
+    }
+}
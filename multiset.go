@@ -0,0 +1,319 @@
+package iblt
+
+import (
+    "fmt"
+    "github.com/dchest/siphash"
+)
+
+// cells of MultisetTable are sums over GF(2^31-1), XOR would cancel
+// even copies, an item is split into chunks small enough to be elements
+const (
+    fieldPrime = 1<<31 - 1
+    chunkBytes = 3
+)
+
+// IBLT over multisets, a bucket holding k copies of one item is pure as well,
+// the item is recovered as dataSum / k and checked against hashSum / k
+type MultisetTable struct {
+    BktNum  uint
+    DataLen int
+    HashNum int
+    Key     SipKey
+    // bucket i spans dataSums[i*chunks:(i+1)*chunks], one element per chunk
+    dataSums []uint32
+    hashSums []uint32
+    counts   []int32
+}
+
+// An item recovered by MultisetTable.Decode, Count is positive if the
+// item occurs more often in the table than in the subtracted one
+type ItemCount struct {
+    Item  []byte
+    Count int
+}
+
+// each recovered item with its signed multiplicity
+type MultisetDiff struct {
+    Items []ItemCount
+    index map[string]int
+}
+
+func NewMultisetDiff() *MultisetDiff {
+    return &MultisetDiff{
+        Items: make([]ItemCount, 0),
+        index: make(map[string]int),
+    }
+}
+
+// multiplicity of item, 0 if not recovered
+func (d MultisetDiff) Count(item []byte) int {
+    if i, ok := d.index[string(item)]; ok {
+        return d.Items[i].Count
+    }
+    return 0
+}
+
+func (d MultisetDiff) Len() int {
+    return len(d.Items)
+}
+
+func (d *MultisetDiff) encode(item []byte, count int) error {
+    if _, ok := d.index[string(item)]; ok {
+        return fmt.Errorf("%w: recovered twice", ErrDuplicateInDiff)
+    }
+    cpy := make([]byte, len(item))
+    copy(cpy, item)
+    d.index[string(cpy)] = len(d.Items)
+    d.Items = append(d.Items, ItemCount{Item: cpy, Count: count})
+    return nil
+}
+
+// Sized by GetCellCount to decode numItems distinct items
+func NewMultiset(numItems uint) *MultisetTable {
+    ibltParam := GetIbltParams(numItems)
+    numCells := GetCellCount(numItems)
+
+    return NewMultisetTable(numCells, DEFAULT_DATA_BYTES, ibltParam.NumHashFuncs)
+}
+
+// Specify number of buckets, data field length (in byte), number of hash functions
+func NewMultisetTable(buckets uint, dataLen int, hashNum int) *MultisetTable {
+    return NewMultisetTableWithKey(buckets, dataLen, hashNum, DEFAULT_KEY)
+}
+
+// Same as NewMultisetTable, but hashes with a caller-supplied SipHash key
+func NewMultisetTableWithKey(buckets uint, dataLen int, hashNum int, key SipKey) *MultisetTable {
+    return &MultisetTable{
+        BktNum:   buckets,
+        DataLen:  dataLen,
+        HashNum:  hashNum,
+        Key:      key,
+        dataSums: make([]uint32, buckets*uint(chunks(dataLen))),
+        hashSums: make([]uint32, buckets),
+        counts:   make([]int32, buckets),
+    }
+}
+
+func (t *MultisetTable) Insert(d []byte) error {
+    return t.InsertN(d, 1)
+}
+
+func (t *MultisetTable) Delete(d []byte) error {
+    return t.InsertN(d, -1)
+}
+
+// Insert n copies of d at once, delete if n is negative
+func (t *MultisetTable) InsertN(d []byte, n int) error {
+    var buf [maxStackHashNum]uint
+    idx, err := t.index(d, buf[:0])
+    if err != nil {
+        return err
+    }
+
+    c := toField(int64(n))
+    h := t.hash(d)
+    for _, i := range idx {
+        sums := t.dataSum(i)
+        for j := range sums {
+            sums[j] = addField(sums[j], mulField(c, chunk(d, j)))
+        }
+        t.hashSums[i] = addField(t.hashSums[i], mulField(c, h))
+        t.counts[i] += int32(n)
+    }
+
+    return nil
+}
+
+func (t MultisetTable) Copy() *MultisetTable {
+    rtn := NewMultisetTableWithKey(t.BktNum, t.DataLen, t.HashNum, t.Key)
+    copy(rtn.dataSums, t.dataSums)
+    copy(rtn.hashSums, t.hashSums)
+    copy(rtn.counts, t.counts)
+
+    return rtn
+}
+
+// Modify callee, t = t - a, multiplicities subtract item by item
+func (t *MultisetTable) Subtract(a *MultisetTable) error {
+    if err := t.keyTable().check(a.keyTable()); err != nil {
+        return err
+    }
+
+    for i := range t.dataSums {
+        t.dataSums[i] = addField(t.dataSums[i], fieldPrime-a.dataSums[i])
+    }
+    for i := range t.hashSums {
+        t.hashSums[i] = addField(t.hashSums[i], fieldPrime-a.hashSums[i])
+        t.counts[i] -= a.counts[i]
+    }
+
+    return nil
+}
+
+// Decode on a scratch copy, t is left intact and could be decoded again
+func (t MultisetTable) DecodeCopy() (*MultisetDiff, error) {
+    return t.Copy().Decode()
+}
+
+// Decode is self-destructive, use DecodeCopy to keep the table.
+// As Table.Decode, fails with a DecodeError alongside the partial diff
+func (t *MultisetTable) Decode() (*MultisetDiff, error) {
+    diff := NewMultisetDiff()
+    idx := make([]uint, 0, t.HashNum)
+    d := make([]byte, t.DataLen)
+
+    for peeled := true; peeled; {
+        peeled = false
+        for i := uint(0); i < t.BktNum; i++ {
+            count := int(t.counts[i])
+            if count == 0 || !t.pure(i, d) {
+                continue
+            }
+            var err error
+            if idx, err = t.index(d, idx[:0]); err != nil {
+                return diff, err
+            }
+            if !contains(idx, i) {
+                // current bucket is a false pure
+                continue
+            }
+            if err = diff.encode(d, count); err != nil {
+                return diff, t.decodeError(err, diff)
+            }
+            if err = t.InsertN(d, -count); err != nil {
+                return diff, err
+            }
+            peeled = true
+        }
+    }
+
+    if !t.empty() {
+        return diff, t.decodeError(ErrDirtyTable, diff)
+    }
+
+    return diff, nil
+}
+
+// t is what is left after peeling
+func (t MultisetTable) decodeError(err error, diff *MultisetDiff) *DecodeError {
+    remaining := 0
+    for i := uint(0); i < t.BktNum; i++ {
+        if !t.bucketEmpty(i) {
+            remaining++
+        }
+    }
+    return &DecodeError{
+        Err:       err,
+        Recovered: diff.Len(),
+        Remaining: remaining,
+    }
+}
+
+// recover the item of bucket idx into d, assuming every copy is the same item
+func (t MultisetTable) pure(idx uint, d []byte) bool {
+    c := toField(int64(t.counts[idx]))
+    if c == 0 {
+        return false
+    }
+    inv := invField(c)
+    for j, sum := range t.dataSum(idx) {
+        v := mulField(sum, inv)
+        // the last chunk may be shorter
+        width := t.DataLen - j*chunkBytes
+        if width > chunkBytes {
+            width = chunkBytes
+        }
+        if v >= 1<<uint(8*width) {
+            return false
+        }
+        for b := 0; b < width; b++ {
+            d[j*chunkBytes+b] = byte(v >> uint(8*(width-1-b)))
+        }
+    }
+    return mulField(t.hashSums[idx], inv) == t.hash(d)
+}
+
+func (t MultisetTable) empty() bool {
+    for i := uint(0); i < t.BktNum; i++ {
+        if !t.bucketEmpty(i) {
+            return false
+        }
+    }
+    return true
+}
+
+func (t MultisetTable) bucketEmpty(idx uint) bool {
+    if t.counts[idx] != 0 || t.hashSums[idx] != 0 {
+        return false
+    }
+    for _, v := range t.dataSum(idx) {
+        if v != 0 {
+            return false
+        }
+    }
+    return true
+}
+
+// parameters viewed as a plain Table, for indexing and checks
+func (t MultisetTable) keyTable() *Table {
+    return &Table{
+        BktNum:  t.BktNum,
+        DataLen: t.DataLen,
+        HashNum: t.HashNum,
+        Key:     t.Key,
+    }
+}
+
+func (t MultisetTable) index(d []byte, idx []uint) ([]uint, error) {
+    return t.keyTable().index(d, idx)
+}
+
+func (t MultisetTable) hash(d []byte) uint32 {
+    return uint32(siphash.Hash(t.Key.K0, t.Key.K1, d) % fieldPrime)
+}
+
+func (t MultisetTable) dataSum(idx uint) []uint32 {
+    n := uint(chunks(t.DataLen))
+    return t.dataSums[idx*n : (idx+1)*n]
+}
+
+func chunks(dataLen int) int {
+    return (dataLen + chunkBytes - 1) / chunkBytes
+}
+
+// j-th chunk of d as a big-endian number
+func chunk(d []byte, j int) uint32 {
+    v := uint32(0)
+    for b := j * chunkBytes; b < (j+1)*chunkBytes && b < len(d); b++ {
+        v = v<<8 | uint32(d[b])
+    }
+    return v
+}
+
+func toField(n int64) uint32 {
+    n %= fieldPrime
+    if n < 0 {
+        n += fieldPrime
+    }
+    return uint32(n)
+}
+
+func addField(a, b uint32) uint32 {
+    return uint32((uint64(a) + uint64(b)) % fieldPrime)
+}
+
+func mulField(a, b uint32) uint32 {
+    return uint32(uint64(a) * uint64(b) % fieldPrime)
+}
+
+// Fermat, a^(p-2), a must not be 0
+func invField(a uint32) uint32 {
+    rtn := uint32(1)
+    for e := uint32(fieldPrime - 2); e > 0; e >>= 1 {
+        if e&1 == 1 {
+            rtn = mulField(rtn, a)
+        }
+        a = mulField(a, a)
+    }
+    return rtn
+}
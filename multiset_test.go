@@ -0,0 +1,104 @@
+package iblt
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+)
+
+func TestMultisetTable(t *testing.T) {
+    for _, dataLen := range []int{4, 6, 16} {
+        alpha := NewMultisetTable(200, dataLen, 4)
+        beta := NewMultisetTable(200, dataLen, 4)
+        want := make(map[string]int)
+        for i := 0; i < 100; i ++ {
+            b := make([]byte, dataLen)
+            rand.Read(b)
+            a, c := rand.Intn(5), rand.Intn(5)
+            // shared items dominate, the difference is small
+            if i >= 50 {
+                c = a
+            }
+            alpha.InsertN(b, a + 10)
+            beta.InsertN(b, c + 10)
+            if a != c {
+                want[string(b)] = a - c
+            }
+        }
+
+        if err := alpha.Subtract(beta); err != nil {
+            t.Errorf("subtract failed error: %v", err)
+        }
+        diff, err := alpha.Decode()
+        if err != nil {
+            t.Errorf("decode failed error: %v, data length: %d", err, dataLen)
+        }
+        if diff.Len() != len(want) {
+            t.Errorf("number of recovered items want %d, get %d", len(want), diff.Len())
+        }
+        for item, count := range want {
+            if diff.Count([]byte(item)) != count {
+                t.Errorf("multiplicity want %d, get %d", count, diff.Count([]byte(item)))
+            }
+        }
+    }
+}
+
+func TestMultisetTable_Copies(t *testing.T) {
+    table := NewMultiset(10)
+    b := make([]byte, DEFAULT_DATA_BYTES)
+    rand.Read(b)
+    for i := 0; i < 7; i ++ {
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    table.Delete(b)
+    cpy := table.Copy()
+
+    diff, err := table.DecodeCopy()
+    if err != nil {
+        t.Errorf("decode failed error: %v", err)
+    }
+    if diff.Len() != 1 || diff.Count(b) != 6 {
+        t.Errorf("want one item of 6 copies, get %v", diff.Items)
+    }
+    if err := cpy.Subtract(table); err != nil || !cpy.empty() {
+        t.Error("decode copy should leave the table intact")
+    }
+
+    if err := table.Insert(make([]byte, 5)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("insert want ErrDataLength, get %v", err)
+    }
+    if err := table.Subtract(NewMultisetTable(table.BktNum + 1, table.DataLen, table.HashNum)); !errors.Is(err, ErrParamMismatch) {
+        t.Errorf("subtract want ErrParamMismatch, get %v", err)
+    }
+
+    overloaded := NewMultisetTable(20, DEFAULT_DATA_BYTES, 4)
+    for i := 0; i < 100; i ++ {
+        rand.Read(b)
+        overloaded.InsertN(b, i)
+    }
+    diff, err = overloaded.Decode()
+    if !errors.Is(err, ErrDirtyTable) {
+        t.Errorf("overloaded table want ErrDirtyTable, get %v", err)
+    }
+    var decodeErr *DecodeError
+    if !errors.As(err, &decodeErr) {
+        t.Fatalf("overloaded table want DecodeError, get %v", err)
+    }
+    if decodeErr.Recovered != diff.Len() || decodeErr.Remaining == 0 {
+        t.Errorf("decode error mismatched, %d items recovered, get %v", diff.Len(), decodeErr)
+    }
+}
+
+func TestInvField(t *testing.T) {
+    for _, a := range []uint32{1, 2, 3, 1000, fieldPrime - 1} {
+        if mulField(a, invField(a)) != 1 {
+            t.Errorf("inverse of %d is wrong", a)
+        }
+    }
+    if toField(-1) != fieldPrime-1 {
+        t.Errorf("field element of -1 want %d, get %d", fieldPrime-1, toField(-1))
+    }
+}
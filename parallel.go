@@ -0,0 +1,72 @@
+package iblt
+
+import (
+    "fmt"
+    "sync"
+)
+
+// Insert every item, same as calling Insert on each
+func (t *Table) InsertBatch(items [][]byte) error {
+    idx := make([]uint, 0, t.HashNum)
+    for n, d := range items {
+        d, err := t.item(d)
+        if err != nil {
+            return fmt.Errorf("item %d: %w", n, err)
+        }
+        if idx, err = t.index(d, idx[:0]); err != nil {
+            return fmt.Errorf("item %d: %w", n, err)
+        }
+        h := t.Key.sipHash(d)
+        for _, i := range idx {
+            t.operateBucket(i, d, h[:], true)
+        }
+    }
+
+    return nil
+}
+
+// Insert every item with workers goroutines, each into a private partial table,
+// partial tables are summed into t at the end since cells are linear
+func (t *Table) InsertParallel(items [][]byte, workers int) error {
+    if workers < 1 {
+        workers = 1
+    }
+    if workers > len(items) {
+        workers = len(items)
+    }
+    if workers <= 1 {
+        return t.InsertBatch(items)
+    }
+
+    partials := make([]*Table, workers)
+    errs := make([]error, workers)
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        partials[w] = t.emptyCopy()
+        lo, hi := w*len(items)/workers, (w+1)*len(items)/workers
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            if err := partials[w].InsertBatch(items[lo:hi]); err != nil {
+                errs[w] = fmt.Errorf("shard from %d: %w", lo, err)
+            }
+        }(w)
+    }
+    wg.Wait()
+
+    for w := range partials {
+        if errs[w] != nil {
+            return errs[w]
+        }
+    }
+    return t.Merge(partials...)
+}
+
+// Build a table sized by New to decode all items, inserting with workers goroutines
+func BuildParallel(items [][]byte, workers int) (*Table, error) {
+    table := New(uint(len(items)))
+    if err := table.InsertParallel(items, workers); err != nil {
+        return nil, err
+    }
+    return table, nil
+}
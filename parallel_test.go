@@ -0,0 +1,64 @@
+package iblt
+
+import (
+    "errors"
+    "fmt"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func randomItems(n int, dataLen int) [][]byte {
+    items := make([][]byte, n)
+    for i := range items {
+        items[i] = make([]byte, dataLen)
+        rand.Read(items[i])
+    }
+    return items
+}
+
+func TestBuildParallel(t *testing.T) {
+    items := randomItems(1000, DEFAULT_DATA_BYTES)
+    expected := New(uint(len(items)))
+    for _, b := range items {
+        if err := expected.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+
+    for _, workers := range []int{0, 1, 3, 8, 2000} {
+        table, err := BuildParallel(items, workers)
+        if err != nil {
+            t.Errorf("build failed error: %v, workers: %d", err, workers)
+        }
+        if !reflect.DeepEqual(table, expected) {
+            t.Errorf("parallel table not equal to sequential table, workers: %d", workers)
+        }
+    }
+
+    items[500] = make([]byte, 3)
+    if _, err := BuildParallel(items, 4); !errors.Is(err, ErrDataLength) {
+        t.Errorf("build want ErrDataLength, get %v", err)
+    }
+}
+
+func BenchmarkTable_InsertBatch(b *testing.B) {
+    items := randomItems(100000, DEFAULT_DATA_BYTES)
+    b.ResetTimer()
+    for i := 0; i < b.N; i ++ {
+        table := New(1000)
+        table.InsertBatch(items)
+    }
+}
+
+func BenchmarkBuildParallel(b *testing.B) {
+    items := randomItems(1000000, DEFAULT_DATA_BYTES)
+    for _, workers := range []int{1, 2, 4, 8} {
+        b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+            for i := 0; i < b.N; i ++ {
+                table := New(10000)
+                table.InsertParallel(items, workers)
+            }
+        })
+    }
+}
@@ -0,0 +1,478 @@
+package iblt
+
+import (
+    "bufio"
+    "bytes"
+    "encoding/binary"
+    "fmt"
+    "hash"
+    "hash/crc32"
+    "io"
+    "github.com/willf/bitset"
+)
+
+// Serialized table starts with magic followed by a version byte,
+// bumped whenever the layout changes
+const (
+    serialMagic   = "IBLT"
+    serialVersion = 7
+)
+
+// bits of the flags header field
+const (
+    flagPartitioned = 1 << iota
+    flagVariableLen
+)
+
+// bucket layouts, WriteTo picks whichever is smaller
+const (
+    layoutSparse = byte(0)
+    layoutDense  = byte(1)
+)
+
+// upper bounds ReadTable accepts, guarding allocations against bogus headers,
+// fields within their own bounds could still multiply to a huge table,
+// callers expecting smaller tables should pass their own limit to ReadTableWithLimit
+const (
+    maxBktNum     = 1 << 32
+    maxDataLen    = 1 << 16
+    maxTableBytes = 1 << 30
+)
+
+// Serialize layout, all unsigned integers are uvarint encoded:
+// magic | version | BktNum | DataLen | HashLen | HashNum | CountLen | flags | Key (2 x 8 bytes) | layout
+// in sparse layout followed by number of buckets and every non-empty bucket as
+// index delta | count (CountLen bytes) | dataSum | hashSum
+// where index delta is the distance to the previous non-empty bucket,
+// in dense layout followed by an occupancy bitmap of BktNum bits and every non-empty bucket as
+// count (CountLen bytes) | dataSum | hashSum
+// and finally a trailing CRC-32 (IEEE) of everything before it
+func (t Table) Serialize() ([]byte, error) {
+    var buffer bytes.Buffer
+    if _, err := t.WriteTo(&buffer); err != nil {
+        return nil, err
+    }
+    return buffer.Bytes(), nil
+}
+
+func Deserialize(b []byte) (*Table, error) {
+    reader := bytes.NewReader(b)
+    if _, err := readTable(reader, maxTableBytes, false); err != nil {
+        return nil, err
+    }
+    if reader.Len() != 0 {
+        return nil, fmt.Errorf("%w: trailing bytes after table", ErrMalformed)
+    }
+    return readTable(bytes.NewReader(b), maxTableBytes, true)
+}
+
+// Implements encoding.BinaryMarshaler
+func (t Table) MarshalBinary() ([]byte, error) {
+    return t.Serialize()
+}
+
+// Implements encoding.BinaryUnmarshaler, replaces everything in t
+func (t *Table) UnmarshalBinary(b []byte) error {
+    table, err := Deserialize(b)
+    if err != nil {
+        return err
+    }
+    *t = *table
+    return nil
+}
+
+// Implements io.WriterTo, streams the Serialize layout to w
+func (t Table) WriteTo(w io.Writer) (int64, error) {
+    // compare index overhead of both layouts, bucket contents cost the same
+    sparse, prev := 0, 0
+    occupied := t.occupied()
+    for i, e := occupied.NextSet(0); e; i, e = occupied.NextSet(i + 1) {
+        sparse += uvarintLen(uint64(int(i) - prev))
+        prev = int(i)
+    }
+    sparse += uvarintLen(uint64(occupied.Count()))
+
+    if bitmapLen(t.BktNum) < sparse {
+        return t.writeTo(w, layoutDense)
+    }
+    return t.writeTo(w, layoutSparse)
+}
+
+func (t Table) writeTo(w io.Writer, layout byte) (int64, error) {
+    if !validCountLen(t.CountLen) {
+        return 0, fmt.Errorf("%w: unsupported count length %d", ErrBadParams, t.CountLen)
+    }
+    // validate before writing, so that w never sees a partial table
+    occupied := t.occupied()
+    for i, e := occupied.NextSet(0); e; i, e = occupied.NextSet(i + 1) {
+        if err := checkCount(int(t.counts[i]), t.CountLen); err != nil {
+            return 0, err
+        }
+    }
+
+    bw := bufio.NewWriter(w)
+    tw := &tableWriter{w: bw, crc: crc32.NewIEEE()}
+    tw.Write([]byte(serialMagic))
+    tw.Write([]byte{serialVersion})
+    flags := uint64(0)
+    if t.Partitioned {
+        flags |= flagPartitioned
+    }
+    if t.VariableLen {
+        flags |= flagVariableLen
+    }
+    for _, unsigned := range []uint64{uint64(t.BktNum), uint64(t.DataLen), uint64(t.HashLen), uint64(t.HashNum), uint64(t.CountLen), flags,} {
+        tw.writeUvarint(unsigned)
+    }
+    tw.writeUint64(t.Key.K0)
+    tw.writeUint64(t.Key.K1)
+    tw.Write([]byte{layout})
+
+    if layout == layoutDense {
+        tw.Write(bitmapBytes(occupied, t.BktNum))
+    } else {
+        tw.writeUvarint(uint64(occupied.Count()))
+    }
+
+    prev := uint(0)
+    for i, e := occupied.NextSet(0); e; i, e = occupied.NextSet(i + 1) {
+        if layout == layoutSparse {
+            tw.writeUvarint(uint64(i - prev))
+            prev = i
+        }
+        bkt := t.bucket(i)
+        tw.writeCount(bkt.count, t.CountLen)
+        tw.Write(bkt.dataSum)
+        tw.Write(bkt.hashSum)
+    }
+
+    binary.BigEndian.PutUint32(tw.buf[:], tw.crc.Sum32())
+    tw.Write(tw.buf[:crc32.Size])
+    if tw.err != nil {
+        return tw.n, tw.err
+    }
+    return tw.n, bw.Flush()
+}
+
+// Reads exactly one serialized table from r, never reading past its checksum
+func ReadTable(r io.Reader) (*Table, error) {
+    return ReadTableWithLimit(r, maxTableBytes)
+}
+
+// Same as ReadTable, but rejects tables whose buckets would take more than
+// maxBytes in memory, checked against the header before anything is allocated.
+// The bytes read are kept aside and the table is built from them only once
+// the checksum matched, so memory grows with the input rather than with the header
+func ReadTableWithLimit(r io.Reader, maxBytes uint64) (*Table, error) {
+    var raw bytes.Buffer
+    if _, err := readTable(io.TeeReader(r, &raw), maxBytes, false); err != nil {
+        return nil, err
+    }
+    return readTable(&raw, maxBytes, true)
+}
+
+// unless build is set, buckets are only checked and the returned table is nil,
+// nothing is allocated in proportion to the header before the checksum is verified
+func readTable(r io.Reader, maxBytes uint64, build bool) (*Table, error) {
+    tr := &tableReader{r: r, crc: crc32.NewIEEE()}
+
+    magic := make([]byte, len(serialMagic))
+    if _, err := io.ReadFull(tr, magic); err != nil {
+        return nil, fmt.Errorf("%w: truncated magic number: %v", ErrMalformed, err)
+    }
+    if string(magic) != serialMagic {
+        return nil, fmt.Errorf("%w: bad magic number", ErrMalformed)
+    }
+    version, err := tr.ReadByte()
+    if err != nil {
+        return nil, fmt.Errorf("%w: truncated version: %v", ErrMalformed, err)
+    }
+    if version != serialVersion {
+        return nil, fmt.Errorf("%w: unsupported version %d", ErrMalformed, version)
+    }
+
+    params := make([]uint64, 6)
+    for i := range params {
+        if params[i], err = binary.ReadUvarint(tr); err != nil {
+            return nil, fmt.Errorf("%w: truncated header: %v", ErrMalformed, err)
+        }
+    }
+    if params[0] == 0 || params[0] > maxBktNum {
+        return nil, fmt.Errorf("%w: illegal bucket number %d", ErrMalformed, params[0])
+    }
+    if params[1] == 0 || params[1] > maxDataLen {
+        return nil, fmt.Errorf("%w: illegal data length %d", ErrMalformed, params[1])
+    }
+    if params[2] > 8 {
+        return nil, fmt.Errorf("%w: illegal hash length %d", ErrMalformed, params[2])
+    }
+    // dataSum, hashSum and an 8 byte count per bucket
+    if params[0] * (params[1] + params[2] + 8) > maxBytes {
+        return nil, fmt.Errorf("%w: table of %d buckets of %d bytes too large", ErrMalformed, params[0], params[1] + params[2])
+    }
+    if params[3] == 0 || params[3] > params[0] {
+        return nil, fmt.Errorf("%w: illegal number of hash functions %d", ErrMalformed, params[3])
+    }
+    bktNum, dataLen, hashLen, hashNum := uint(params[0]), int(params[1]), int(params[2]), int(params[3])
+    countLen := int(params[4])
+    if !validCountLen(countLen) {
+        return nil, fmt.Errorf("%w: illegal count length %d", ErrMalformed, countLen)
+    }
+    flags := params[5]
+    if flags &^ (flagPartitioned | flagVariableLen) != 0 {
+        return nil, fmt.Errorf("%w: unknown flags %x", ErrMalformed, flags)
+    }
+    partitioned := flags & flagPartitioned != 0
+    if partitioned && bktNum % uint(hashNum) != 0 {
+        return nil, fmt.Errorf("%w: partitioned bucket number %d is not a multiple of %d", ErrMalformed, bktNum, hashNum)
+    }
+
+    keys := make([]uint64, 2)
+    for i := range keys {
+        if keys[i], err = tr.readUint64(); err != nil {
+            return nil, fmt.Errorf("%w: truncated hash key", ErrMalformed)
+        }
+    }
+    layout, err := tr.ReadByte()
+    if err != nil {
+        return nil, fmt.Errorf("%w: truncated header: %v", ErrMalformed, err)
+    }
+
+    var table *Table
+    scratch := Bucket{dataSum: make([]byte, dataLen), hashSum: make([]byte, hashLen)}
+    if build {
+        table = NewTableWithKey(bktNum, dataLen, hashLen, hashNum, SipKey{K0: keys[0], K1: keys[1]})
+        table.CountLen = countLen
+        table.Partitioned = partitioned
+        table.VariableLen = flags & flagVariableLen != 0
+    }
+    switch layout {
+    case layoutSparse:
+        encoded, err := binary.ReadUvarint(tr)
+        if err != nil {
+            return nil, fmt.Errorf("%w: truncated header: %v", ErrMalformed, err)
+        }
+        if encoded > uint64(bktNum) {
+            return nil, fmt.Errorf("%w: illegal number of encoded buckets %d", ErrMalformed, encoded)
+        }
+        idx := uint64(0)
+        for i := uint64(0); i < encoded; i++ {
+            delta, err := binary.ReadUvarint(tr)
+            if err != nil {
+                return nil, fmt.Errorf("%w: truncated bucket index: %v", ErrMalformed, err)
+            }
+            if delta == 0 && i != 0 {
+                return nil, fmt.Errorf("%w: duplicated bucket index", ErrMalformed)
+            }
+            idx += delta
+            if delta >= uint64(bktNum) || idx >= uint64(bktNum) {
+                return nil, fmt.Errorf("%w: bucket index out of range %d", ErrMalformed, idx)
+            }
+            if err = table.readBucket(tr, uint(idx), scratch, countLen); err != nil {
+                return nil, err
+            }
+        }
+    case layoutDense:
+        occupied, err := readBitmap(tr, bktNum)
+        if err != nil {
+            return nil, err
+        }
+        for idx, e := occupied.NextSet(0); e; idx, e = occupied.NextSet(idx + 1) {
+            if err = table.readBucket(tr, idx, scratch, countLen); err != nil {
+                return nil, err
+            }
+        }
+    default:
+        return nil, fmt.Errorf("%w: unknown bucket layout %d", ErrMalformed, layout)
+    }
+
+    sum := tr.crc.Sum32()
+    if _, err := io.ReadFull(tr.r, tr.buf[:crc32.Size]); err != nil {
+        return nil, fmt.Errorf("%w: truncated checksum", ErrMalformed)
+    }
+    if binary.BigEndian.Uint32(tr.buf[:crc32.Size]) != sum {
+        return nil, fmt.Errorf("%w: checksum mismatch", ErrMalformed)
+    }
+
+    return table, nil
+}
+
+// reads into scratch if t is nil
+func (t *Table) readBucket(r *tableReader, idx uint, scratch Bucket, countLen int) error {
+    bkt := scratch
+    if t != nil {
+        bkt = t.bucket(idx)
+    }
+    var err error
+    if bkt.count, err = readCount(r, countLen); err != nil {
+        return fmt.Errorf("%w: truncated bucket count at %d", ErrMalformed, idx)
+    }
+    if _, err = io.ReadFull(r, bkt.dataSum); err != nil {
+        return fmt.Errorf("%w: truncated bucket dataSum at %d", ErrMalformed, idx)
+    }
+    if _, err = io.ReadFull(r, bkt.hashSum); err != nil {
+        return fmt.Errorf("%w: truncated bucket hashSum at %d", ErrMalformed, idx)
+    }
+    if bkt.empty() {
+        return fmt.Errorf("%w: empty bucket encoded at %d", ErrMalformed, idx)
+    }
+    if t != nil {
+        t.counts[idx] = int64(bkt.count)
+    }
+    return nil
+}
+
+func validCountLen(countLen int) bool {
+    switch countLen {
+    case 0, 1, 2, 4, 8:
+        return true
+    }
+    return false
+}
+
+func checkCount(count int, countLen int) error {
+    bits := uint(countLen * 8)
+    if bits != 0 && bits < 64 && (int64(count) < -1<<(bits-1) || int64(count) >= 1<<(bits-1)) {
+        return fmt.Errorf("%w: count %d in %d bytes", ErrCountOverflow, count, countLen)
+    }
+    return nil
+}
+
+// tableWriter checksums everything written through it if crc is set, and keeps the first error
+type tableWriter struct {
+    w   io.Writer
+    crc hash.Hash32
+    n   int64
+    err error
+    buf [binary.MaxVarintLen64]byte
+}
+
+func (w *tableWriter) Write(p []byte) (int, error) {
+    if w.err != nil {
+        return 0, w.err
+    }
+    n, err := w.w.Write(p)
+    if w.crc != nil {
+        w.crc.Write(p[:n])
+    }
+    w.n += int64(n)
+    w.err = err
+    return n, err
+}
+
+func (w *tableWriter) writeUvarint(v uint64) {
+    n := binary.PutUvarint(w.buf[:], v)
+    w.Write(w.buf[:n])
+}
+
+func (w *tableWriter) writeUint64(v uint64) {
+    binary.BigEndian.PutUint64(w.buf[:], v)
+    w.Write(w.buf[:8])
+}
+
+// count is written big endian in countLen bytes, or as a zigzag varint if countLen is 0
+func (w *tableWriter) writeCount(count int, countLen int) {
+    if countLen == 0 {
+        n := binary.PutVarint(w.buf[:], int64(count))
+        w.Write(w.buf[:n])
+        return
+    }
+    binary.BigEndian.PutUint64(w.buf[:], uint64(count))
+    w.Write(w.buf[8-countLen:8])
+}
+
+// tableReader checksums everything read through it if crc is set, reading
+// byte by byte is fine since callers are expected to hand in buffered readers
+type tableReader struct {
+    r   io.Reader
+    crc hash.Hash32
+    buf [8]byte
+}
+
+func (r *tableReader) Read(p []byte) (int, error) {
+    n, err := r.r.Read(p)
+    if r.crc != nil {
+        r.crc.Write(p[:n])
+    }
+    return n, err
+}
+
+func (r *tableReader) ReadByte() (byte, error) {
+    if _, err := io.ReadFull(r, r.buf[:1]); err != nil {
+        return 0, err
+    }
+    return r.buf[0], nil
+}
+
+func (r *tableReader) readUint64() (uint64, error) {
+    if _, err := io.ReadFull(r, r.buf[:8]); err != nil {
+        return 0, err
+    }
+    return binary.BigEndian.Uint64(r.buf[:8]), nil
+}
+
+func readCount(r *tableReader, countLen int) (int, error) {
+    if countLen == 0 {
+        count, err := binary.ReadVarint(r)
+        return int(count), err
+    }
+
+    for i := range r.buf {
+        r.buf[i] = 0
+    }
+    if _, err := io.ReadFull(r, r.buf[8-countLen:]); err != nil {
+        return 0, err
+    }
+    // sign extend from countLen bytes
+    shift := uint(64 - countLen*8)
+    return int(int64(binary.BigEndian.Uint64(r.buf[:])<<shift) >> shift), nil
+}
+
+// non-empty buckets
+func (t Table) occupied() *bitset.BitSet {
+    occupied := bitset.New(t.BktNum)
+    for i := uint(0); i < t.BktNum; i++ {
+        if !t.bucket(i).empty() {
+            occupied.Set(i)
+        }
+    }
+    return occupied
+}
+
+func uvarintLen(v uint64) int {
+    buf := make([]byte, binary.MaxVarintLen64)
+    return binary.PutUvarint(buf, v)
+}
+
+func bitmapLen(bktNum uint) int {
+    return int((bktNum + 7) / 8)
+}
+
+// bit i is stored in byte i/8 at position i%8
+func bitmapBytes(occupied *bitset.BitSet, bktNum uint) []byte {
+    words := occupied.Bytes()
+    b := make([]byte, 8*len(words))
+    for i, word := range words {
+        binary.LittleEndian.PutUint64(b[8*i:], word)
+    }
+    return b[:bitmapLen(bktNum)]
+}
+
+// copied in chunks, so that a truncated input never commits the whole bitmap
+func readBitmap(r io.Reader, bktNum uint) (*bitset.BitSet, error) {
+    var buffer bytes.Buffer
+    if _, err := io.CopyN(&buffer, r, int64(bitmapLen(bktNum))); err != nil {
+        return nil, fmt.Errorf("%w: truncated occupancy bitmap", ErrMalformed)
+    }
+    b := buffer.Bytes()
+    b = append(b, make([]byte, (len(b)+7)/8*8-len(b))...)
+    words := make([]uint64, len(b)/8)
+    for i := range words {
+        words[i] = binary.LittleEndian.Uint64(b[8*i:])
+    }
+    occupied := bitset.From(words)
+    if idx, e := occupied.NextSet(bktNum); e {
+        return nil, fmt.Errorf("%w: bucket index out of range %d", ErrMalformed, idx)
+    }
+    return occupied, nil
+}
@@ -0,0 +1,101 @@
+package iblt
+
+import (
+    "bytes"
+    "encoding/gob"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func TestWriteToReadTable(t *testing.T) {
+    var buffer bytes.Buffer
+    var tables []*Table
+
+    // stream several tables back to back, ReadTable must consume exactly one each time
+    for _, test := range tests {
+        table := NewTable(test.bktNum, test.dataLen, test.hashLen, test.hashNum)
+        b := make([]byte, test.dataLen)
+        for i := 0; i < test.alphaItems; i ++ {
+            rand.Read(b)
+            if err := table.Insert(b); err != nil {
+                t.Errorf("test Insert failed error: %v", err)
+            }
+        }
+        n, err := table.WriteTo(&buffer)
+        if err != nil {
+            t.Errorf("table write error %v", err)
+        }
+        enc, _ := table.Serialize()
+        if n != int64(len(enc)) {
+            t.Errorf("written bytes mismatch want %d, get %d", len(enc), n)
+        }
+        tables = append(tables, table.Copy())
+    }
+
+    for _, table := range tables {
+        rec, err := ReadTable(&buffer)
+        if err != nil {
+            t.Errorf("table read error %v", err)
+        }
+        if !reflect.DeepEqual(rec, table) {
+            t.Error("streamed IBLT not equal")
+        }
+    }
+    if buffer.Len() != 0 {
+        t.Errorf("%d bytes left unread", buffer.Len())
+    }
+}
+
+func TestGobTable(t *testing.T) {
+    table := New(50)
+    for i := 0; i < 50; i ++ {
+        b := make([]byte, 6)
+        rand.Read(b)
+        if err := table.Insert(b); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+
+    cpy := table.Copy()
+
+    var buffer bytes.Buffer
+    if err := gob.NewEncoder(&buffer).Encode(table); err != nil {
+        t.Errorf("gob encode error %v", err)
+    }
+    rec := &Table{}
+    if err := gob.NewDecoder(&buffer).Decode(rec); err != nil {
+        t.Errorf("gob decode error %v", err)
+    }
+    if !reflect.DeepEqual(rec, cpy) {
+        t.Error("gob decoded IBLT not equal")
+    }
+}
+
+func TestSerializeLayouts(t *testing.T) {
+    for _, test := range tests {
+        table := NewTable(test.bktNum, test.dataLen, test.hashLen, test.hashNum)
+        b := make([]byte, test.dataLen)
+        for i := 0; i < test.alphaItems; i ++ {
+            rand.Read(b)
+            if err := table.Insert(b); err != nil {
+                t.Errorf("test Insert failed error: %v", err)
+            }
+        }
+        cpy := table.Copy()
+
+        for _, layout := range []byte{layoutSparse, layoutDense} {
+            var buffer bytes.Buffer
+            if _, err := table.writeTo(&buffer, layout); err != nil {
+                t.Errorf("table write error %v, layout: %d", err, layout)
+            }
+            rec, err := Deserialize(buffer.Bytes())
+            if err != nil {
+                t.Errorf("recovery from bytes error %v, layout: %d", err, layout)
+            }
+            if !reflect.DeepEqual(rec, cpy) {
+                t.Errorf("recoveried IBLT not equal, layout: %d", layout)
+            }
+        }
+    }
+}
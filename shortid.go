@@ -0,0 +1,118 @@
+package iblt
+
+import (
+    "bytes"
+    "encoding/binary"
+    "fmt"
+    "github.com/dchest/siphash"
+)
+
+// Reconciles items of any size by inserting a salted short ID of each
+// into the underlying table, as in Bitcoin compact blocks. Recovered IDs
+// are resolved back to local items by an index kept alongside.
+type ShortIDTable struct {
+    // both parties must agree on the salt, a fresh one per reconciliation
+    // keeps an attacker from crafting colliding items in advance
+    Salt  SipKey
+    table *Table
+    items map[string][]byte
+}
+
+// Outcome of ShortIDTable.Resolve
+type Resolved struct {
+    // local items behind the IDs in each part of the Diff
+    Alpha [][]byte
+    Beta  [][]byte
+    // recovered IDs with no local item, the caller should fetch them,
+    // usually from the Beta part after subtracting the remote table
+    Unknown [][]byte
+}
+
+// Short IDs are as long as items of t, at most 16 bytes
+func NewShortIDTable(t *Table, salt SipKey) *ShortIDTable {
+    return &ShortIDTable{
+        Salt:  salt,
+        table: t,
+        items: make(map[string][]byte),
+    }
+}
+
+// Same as NewShortIDTable on New, DEFAULT_DATA_BYTES long IDs
+func NewShortID(numItems uint, salt SipKey) *ShortIDTable {
+    return NewShortIDTable(New(numItems), salt)
+}
+
+// The underlying table of short IDs, to serialize, subtract and decode
+func (s *ShortIDTable) Table() *Table {
+    return s.table
+}
+
+// Short ID of item under the salt
+func (s ShortIDTable) ID(item []byte) ([]byte, error) {
+    idLen := s.table.MaxItemLen()
+    if idLen > 16 {
+        return nil, fmt.Errorf("%w: short ID at most 16, get %d", ErrDataLength, idLen)
+    }
+    var buf [16]byte
+    h1, h2 := siphash.Hash128(s.Salt.K0, s.Salt.K1, item)
+    binary.BigEndian.PutUint64(buf[:8], h1)
+    binary.BigEndian.PutUint64(buf[8:], h2)
+    return buf[:idLen], nil
+}
+
+// Index item and insert its ID, item must not be modified afterwards
+func (s *ShortIDTable) Insert(item []byte) error {
+    id, err := s.ID(item)
+    if err != nil {
+        return err
+    }
+    if _, ok := s.items[string(id)]; ok {
+        return fmt.Errorf("%w: %x", ErrShortIDCollision, id)
+    }
+    if err = s.table.Insert(id); err != nil {
+        return err
+    }
+    s.items[string(id)] = item
+    return nil
+}
+
+// Delete item and drop it from the index, it must have been inserted,
+// another item sharing its ID is not deleted in its place
+func (s *ShortIDTable) Delete(item []byte) error {
+    id, err := s.ID(item)
+    if err != nil {
+        return err
+    }
+    if indexed, ok := s.items[string(id)]; !ok || !bytes.Equal(indexed, item) {
+        return fmt.Errorf("%w: %x", ErrUnknownItem, id)
+    }
+    if err = s.table.Delete(id); err != nil {
+        return err
+    }
+    delete(s.items, string(id))
+    return nil
+}
+
+// Map IDs of diff back to local items, IDs not indexed end up in Unknown
+func (s ShortIDTable) Resolve(diff *Diff) *Resolved {
+    rtn := &Resolved{
+        Alpha:   make([][]byte, 0),
+        Beta:    make([][]byte, 0),
+        Unknown: make([][]byte, 0),
+    }
+    for _, id := range diff.AlphaSlice() {
+        if item, ok := s.items[string(id)]; ok {
+            rtn.Alpha = append(rtn.Alpha, item)
+        } else {
+            rtn.Unknown = append(rtn.Unknown, id)
+        }
+    }
+    for _, id := range diff.BetaSlice() {
+        if item, ok := s.items[string(id)]; ok {
+            rtn.Beta = append(rtn.Beta, item)
+        } else {
+            rtn.Unknown = append(rtn.Unknown, id)
+        }
+    }
+    return rtn
+}
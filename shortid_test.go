@@ -0,0 +1,118 @@
+package iblt
+
+import (
+    "bytes"
+    "errors"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+func TestShortIDTable(t *testing.T) {
+    salt := SipKey{K0: rand.Uint64(), K1: rand.Uint64()}
+    local := NewShortID(40, salt)
+    remote := NewShortID(40, salt)
+    // multi-kilobyte items
+    items := make([][]byte, 1040)
+    for i := range items {
+        items[i] = make([]byte, 2048+rand.Intn(2048))
+        rand.Read(items[i])
+    }
+    onlyLocal, onlyRemote, shared := items[:20:20], items[20:40:40], items[40:]
+    for _, item := range append(onlyLocal, shared...) {
+        if err := local.Insert(item); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    for _, item := range append(onlyRemote, shared...) {
+        if err := remote.Insert(item); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+
+    enc, err := remote.Table().Serialize()
+    if err != nil {
+        t.Errorf("serialize failed error: %v", err)
+    }
+    received, err := Deserialize(enc)
+    if err != nil {
+        t.Errorf("deserialize failed error: %v", err)
+    }
+    diffTable := local.Table().Copy()
+    if err := diffTable.Subtract(received); err != nil {
+        t.Errorf("subtract failed error: %v", err)
+    }
+    diff, err := diffTable.Decode()
+    if err != nil {
+        t.Errorf("decode failed error: %v", err)
+    }
+
+    resolved := local.Resolve(diff)
+    if !reflect.DeepEqual(BytesArrayToSortedString(resolved.Alpha), BytesArrayToSortedString(onlyLocal)) {
+        t.Error("local items not resolved")
+    }
+    if len(resolved.Beta) != 0 {
+        t.Errorf("remote items should not resolve locally, get %d", len(resolved.Beta))
+    }
+    if len(resolved.Unknown) != len(onlyRemote) {
+        t.Errorf("number of unknown IDs want %d, get %d", len(onlyRemote), len(resolved.Unknown))
+    }
+    // the remote side resolves what the local side asks for
+    fetch := remote.Resolve(&Diff{Alpha: diff.Beta, Beta: NewDiff(1).Beta})
+    if !reflect.DeepEqual(BytesArrayToSortedString(fetch.Alpha), BytesArrayToSortedString(onlyRemote)) {
+        t.Error("unknown IDs not resolved on the remote side")
+    }
+}
+
+func TestShortIDTable_ID(t *testing.T) {
+    item := []byte("transaction")
+    a := NewShortID(10, SipKey{K0: 1, K1: 2})
+    b := NewShortID(10, SipKey{K0: 1, K1: 3})
+    idA, _ := a.ID(item)
+    idB, _ := b.ID(item)
+    if len(idA) != DEFAULT_DATA_BYTES {
+        t.Errorf("short ID length want %d, get %d", DEFAULT_DATA_BYTES, len(idA))
+    }
+    if bytes.Equal(idA, idB) {
+        t.Error("different salts should give different short IDs")
+    }
+
+    if err := a.Insert(item); err != nil {
+        t.Errorf("insert failed error: %v", err)
+    }
+    cpy := a.Table().Copy()
+    if err := a.Insert(item); !errors.Is(err, ErrShortIDCollision) {
+        t.Errorf("second insert should fail with ErrShortIDCollision, get %v", err)
+    }
+    if !reflect.DeepEqual(a.Table(), cpy) {
+        t.Error("failed insert should leave the table untouched")
+    }
+    other := []byte("other transaction")
+    if err := a.Delete(other); !errors.Is(err, ErrUnknownItem) {
+        t.Errorf("deleting an item never inserted should fail with ErrUnknownItem, get %v", err)
+    }
+    // pretend other shares the ID of item
+    otherID, _ := a.ID(other)
+    a.items[string(otherID)] = item
+    if err := a.Delete(other); !errors.Is(err, ErrUnknownItem) {
+        t.Errorf("deleting an item behind a colliding ID should fail with ErrUnknownItem, get %v", err)
+    }
+    delete(a.items, string(otherID))
+    if !reflect.DeepEqual(a.Table(), cpy) {
+        t.Error("failed delete should leave the table untouched")
+    }
+    if err := a.Delete(item); err != nil {
+        t.Errorf("delete failed error: %v", err)
+    }
+    if err := a.Delete(item); !errors.Is(err, ErrUnknownItem) {
+        t.Errorf("second delete should fail with ErrUnknownItem, get %v", err)
+    }
+    if err := a.Insert(item); err != nil {
+        t.Errorf("insert after delete failed error: %v", err)
+    }
+
+    long := NewShortIDTable(NewTable(10, 17, 1, 4), SipKey{})
+    if err := long.Insert(item); !errors.Is(err, ErrDataLength) {
+        t.Errorf("short ID longer than 16 bytes should fail with ErrDataLength, get %v", err)
+    }
+}
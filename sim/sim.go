@@ -0,0 +1,282 @@
+// Package sim runs randomized reconciliation trials of iblt tables over
+// parameter grids, to tune the parameters to a workload offline.
+//
+// Every trial inserts Shared common items and Diff items split between two
+// tables, serializes the second one and deserializes it as its peer would,
+// subtracts the received copy from the first one and decodes, then checks
+// the recovered difference against the truth.
+package sim
+
+import (
+    "encoding/csv"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "hash/fnv"
+    "io"
+    "math/rand"
+    "runtime"
+    "strconv"
+    "sync"
+
+    "github.com/SheldonZhong/go-IBLT"
+)
+
+// Every combination of the slices is simulated
+type Grid struct {
+    BktNums  []uint
+    HashNums []int
+    DataLens []int
+    HashLens []int
+    // size of the symmetric difference, the first table gets the odd item
+    Diffs []int
+    // items in both tables, they cancel out on Subtract
+    Shared int
+    // trials per combination
+    Trials int
+    // trials run concurrently, NumCPU if 0
+    Workers int
+    // results are deterministic given the seed, whatever Workers is
+    Seed int64
+}
+
+// A combination of parameters
+type Config struct {
+    BktNum  uint `json:"bkt_num"`
+    HashNum int  `json:"hash_num"`
+    DataLen int  `json:"data_len"`
+    HashLen int  `json:"hash_len"`
+    Diff    int  `json:"diff"`
+}
+
+// Outcome of the trials of a Config
+type Result struct {
+    Config
+    Trials int `json:"trials"`
+    // trials that decoded without error to exactly the true difference
+    Successes   int     `json:"successes"`
+    SuccessRate float64 `json:"success_rate"`
+    // trials where serializing, deserializing, subtracting or decoding returned an error
+    Failures int `json:"failures"`
+    // recovered items not in the true difference, peeled from false pure buckets
+    FalsePures int `json:"false_pures"`
+    // trials where Decode returned no error but a wrong difference
+    Undetected int `json:"undetected"`
+    // mean serialized size of a table in bytes
+    Bytes float64 `json:"bytes"`
+}
+
+var csvHeader = []string{"bkt_num", "hash_num", "data_len", "hash_len", "diff", "trials",
+    "successes", "success_rate", "failures", "false_pures", "undetected", "bytes"}
+
+// Configs of g in the order Run reports them
+func (g Grid) Configs() []Config {
+    configs := make([]Config, 0)
+    for _, bktNum := range g.BktNums {
+        for _, hashNum := range g.HashNums {
+            for _, dataLen := range g.DataLens {
+                for _, hashLen := range g.HashLens {
+                    for _, diff := range g.Diffs {
+                        configs = append(configs, Config{bktNum, hashNum, dataLen, hashLen, diff})
+                    }
+                }
+            }
+        }
+    }
+    return configs
+}
+
+func (g Grid) validate() error {
+    if g.Trials < 1 {
+        return errors.New("at least one trial")
+    }
+    if g.Shared < 0 {
+        return errors.New("negative number of shared items")
+    }
+    for _, c := range g.Configs() {
+        if c.HashNum < 1 || uint(c.HashNum) > c.BktNum {
+            return fmt.Errorf("illegal number of hash functions %d for %d buckets", c.HashNum, c.BktNum)
+        }
+        if c.Diff < 0 || c.HashLen < 0 || c.DataLen < 1 {
+            return fmt.Errorf("illegal config %+v", c)
+        }
+        // the checksum is a 64-bit SipHash
+        if c.HashLen > 8 {
+            return fmt.Errorf("illegal hash length %d", c.HashLen)
+        }
+        // distinct items must exist
+        if c.DataLen < 8 && uint64(c.Diff+g.Shared) > uint64(1)<<uint(8*c.DataLen)/2 {
+            return fmt.Errorf("data length %d too short for %d distinct items", c.DataLen, c.Diff+g.Shared)
+        }
+    }
+    return nil
+}
+
+// Simulate every Config of g, results are in the order of Configs
+func Run(g Grid) ([]Result, error) {
+    if err := g.validate(); err != nil {
+        return nil, err
+    }
+    workers := g.Workers
+    if workers < 1 {
+        workers = runtime.NumCPU()
+    }
+
+    configs := g.Configs()
+    results := make([]Result, len(configs))
+    for i, c := range configs {
+        results[i] = Result{Config: c, Trials: g.Trials}
+    }
+
+    type job struct{ config, trial int }
+    jobs := make(chan job)
+    var mu sync.Mutex
+    var wg sync.WaitGroup
+    for w := 0; w < workers; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for j := range jobs {
+                o := g.trial(configs[j.config], j.trial)
+                mu.Lock()
+                r := &results[j.config]
+                r.FalsePures += o.falsePures
+                r.Bytes += float64(o.bytes)
+                switch {
+                case o.err != nil:
+                    r.Failures++
+                case o.wrong:
+                    r.Undetected++
+                default:
+                    r.Successes++
+                }
+                mu.Unlock()
+            }
+        }()
+    }
+    for c := range configs {
+        for t := 0; t < g.Trials; t++ {
+            jobs <- job{c, t}
+        }
+    }
+    close(jobs)
+    wg.Wait()
+
+    for i := range results {
+        results[i].SuccessRate = float64(results[i].Successes) / float64(g.Trials)
+        results[i].Bytes /= float64(g.Trials)
+    }
+    return results, nil
+}
+
+type outcome struct {
+    err        error
+    wrong      bool
+    falsePures int
+    bytes      int
+}
+
+func (g Grid) trial(c Config, trial int) outcome {
+    h := fnv.New64a()
+    fmt.Fprintf(h, "%d/%+v/%d", g.Seed, c, trial)
+    rng := rand.New(rand.NewSource(int64(h.Sum64())))
+
+    alpha := iblt.NewTable(c.BktNum, c.DataLen, c.HashLen, c.HashNum)
+    beta := iblt.NewTable(c.BktNum, c.DataLen, c.HashLen, c.HashNum)
+    seen := make(map[string]bool)
+    item := func() []byte {
+        b := make([]byte, c.DataLen)
+        for {
+            rng.Read(b)
+            if !seen[string(b)] {
+                seen[string(b)] = true
+                return b
+            }
+        }
+    }
+    for i := 0; i < g.Shared; i++ {
+        b := item()
+        alpha.Insert(b)
+        beta.Insert(b)
+    }
+    truth := make(map[string]int)
+    for i := 0; i < c.Diff; i++ {
+        b := item()
+        if i%2 == 0 {
+            alpha.Insert(b)
+            truth[string(b)] = 1
+        } else {
+            beta.Insert(b)
+            truth[string(b)] = -1
+        }
+    }
+
+    var o outcome
+    enc, err := beta.Serialize()
+    if err != nil {
+        o.err = err
+        return o
+    }
+    o.bytes = len(enc)
+    received, err := iblt.Deserialize(enc)
+    if err != nil {
+        o.err = err
+        return o
+    }
+    if err := alpha.Subtract(received); err != nil {
+        o.err = err
+        return o
+    }
+    diff, err := alpha.Decode()
+    o.err = err
+
+    recovered := 0
+    check := func(items [][]byte, sign int) {
+        for _, b := range items {
+            recovered++
+            if truth[string(b)] != sign {
+                o.falsePures++
+            }
+        }
+    }
+    check(diff.AlphaSlice(), 1)
+    check(diff.BetaSlice(), -1)
+    o.wrong = o.falsePures > 0 || recovered != len(truth)
+    return o
+}
+
+// One row per result after a header
+func WriteCSV(w io.Writer, results []Result) error {
+    cw := csv.NewWriter(w)
+    if err := cw.Write(csvHeader); err != nil {
+        return err
+    }
+    for _, r := range results {
+        row := []string{
+            strconv.FormatUint(uint64(r.BktNum), 10),
+            strconv.Itoa(r.HashNum),
+            strconv.Itoa(r.DataLen),
+            strconv.Itoa(r.HashLen),
+            strconv.Itoa(r.Diff),
+            strconv.Itoa(r.Trials),
+            strconv.Itoa(r.Successes),
+            strconv.FormatFloat(r.SuccessRate, 'f', -1, 64),
+            strconv.Itoa(r.Failures),
+            strconv.Itoa(r.FalsePures),
+            strconv.Itoa(r.Undetected),
+            strconv.FormatFloat(r.Bytes, 'f', -1, 64),
+        }
+        if err := cw.Write(row); err != nil {
+            return err
+        }
+    }
+    cw.Flush()
+    return cw.Error()
+}
+
+// A JSON array of results
+func WriteJSON(w io.Writer, results []Result) error {
+    enc := json.NewEncoder(w)
+    enc.SetIndent("", "  ")
+    return enc.Encode(results)
+}
@@ -0,0 +1,143 @@
+package sim
+
+import (
+    "bytes"
+    "encoding/csv"
+    "encoding/json"
+    "reflect"
+    "testing"
+)
+
+var grid = Grid{
+    BktNums:  []uint{40, 200},
+    HashNums: []int{3, 4},
+    DataLens: []int{6},
+    HashLens: []int{1, 3},
+    Diffs:    []int{10, 40},
+    Shared:   20,
+    Trials:   50,
+    Seed:     1,
+}
+
+func TestRun(t *testing.T) {
+    results, err := Run(grid)
+    if err != nil {
+        t.Fatalf("run failed error: %v", err)
+    }
+    configs := grid.Configs()
+    if len(results) != 16 || len(configs) != 16 {
+        t.Fatalf("number of results want 16, get %d", len(results))
+    }
+    for i, r := range results {
+        if r.Config != configs[i] {
+            t.Errorf("result %d config want %+v, get %+v", i, configs[i], r.Config)
+        }
+        if r.Successes+r.Failures+r.Undetected != r.Trials {
+            t.Errorf("trial outcomes should add up, get %+v", r)
+        }
+        if r.Bytes <= 0 {
+            t.Errorf("serialized size should be positive, get %v", r.Bytes)
+        }
+        // 10 items in 200 buckets always decode
+        if r.BktNum == 200 && r.Diff == 10 && r.HashLen == 3 && r.SuccessRate != 1 {
+            t.Errorf("sparse config should always decode, get %+v", r)
+        }
+        // 40 items in 40 buckets are beyond the peeling threshold
+        if r.BktNum == 40 && r.Diff == 40 && r.SuccessRate > 0.5 {
+            t.Errorf("overloaded config should mostly fail, get %+v", r)
+        }
+    }
+
+    serial := grid
+    serial.Workers = 1
+    again, err := Run(serial)
+    if err != nil {
+        t.Fatalf("run failed error: %v", err)
+    }
+    if !reflect.DeepEqual(results, again) {
+        t.Error("results should not depend on the number of workers")
+    }
+}
+
+func TestRunFalsePure(t *testing.T) {
+    // without hash checksum pure buckets are only told by the index check
+    results, err := Run(Grid{
+        BktNums:  []uint{30},
+        HashNums: []int{3},
+        DataLens: []int{4},
+        HashLens: []int{0},
+        Diffs:    []int{30},
+        Trials:   200,
+        Seed:     1,
+    })
+    if err != nil {
+        t.Fatalf("run failed error: %v", err)
+    }
+    if results[0].FalsePures == 0 {
+        t.Errorf("false pure buckets should be detected, get %+v", results[0])
+    }
+}
+
+func TestRunSerializeError(t *testing.T) {
+    // every shared item lands in all 3 buckets, whose counts overflow the 2 byte count
+    results, err := Run(Grid{
+        BktNums:  []uint{3},
+        HashNums: []int{3},
+        DataLens: []int{4},
+        HashLens: []int{1},
+        Diffs:    []int{0},
+        Shared:   1 << 15,
+        Trials:   2,
+        Seed:     1,
+    })
+    if err != nil {
+        t.Fatalf("run failed error: %v", err)
+    }
+    if results[0].Failures != 2 || results[0].Bytes != 0 {
+        t.Errorf("unserializable tables should fail every trial, get %+v", results[0])
+    }
+}
+
+func TestRunInvalid(t *testing.T) {
+    for _, g := range []Grid{
+        {BktNums: []uint{10}, HashNums: []int{4}, DataLens: []int{6}, HashLens: []int{1}, Diffs: []int{1}},
+        {BktNums: []uint{3}, HashNums: []int{4}, DataLens: []int{6}, HashLens: []int{1}, Diffs: []int{1}, Trials: 1},
+        {BktNums: []uint{10}, HashNums: []int{4}, DataLens: []int{1}, HashLens: []int{1}, Diffs: []int{200}, Trials: 1},
+        {BktNums: []uint{10}, HashNums: []int{3}, DataLens: []int{6}, HashLens: []int{9}, Diffs: []int{1}, Trials: 1},
+    } {
+        if _, err := Run(g); err == nil {
+            t.Errorf("illegal grid should fail %+v", g)
+        }
+    }
+}
+
+func TestWrite(t *testing.T) {
+    results, err := Run(grid)
+    if err != nil {
+        t.Fatalf("run failed error: %v", err)
+    }
+
+    var buf bytes.Buffer
+    if err := WriteCSV(&buf, results); err != nil {
+        t.Errorf("write csv failed error: %v", err)
+    }
+    rows, err := csv.NewReader(&buf).ReadAll()
+    if err != nil {
+        t.Errorf("read csv failed error: %v", err)
+    }
+    if len(rows) != len(results)+1 || !reflect.DeepEqual(rows[0], csvHeader) {
+        t.Errorf("csv should be a header and a row per result, get %d rows", len(rows))
+    }
+
+    buf.Reset()
+    if err := WriteJSON(&buf, results); err != nil {
+        t.Errorf("write json failed error: %v", err)
+    }
+    var decoded []Result
+    if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+        t.Errorf("read json failed error: %v", err)
+    }
+    if !reflect.DeepEqual(decoded, results) {
+        t.Error("json should round trip the results")
+    }
+}
@@ -0,0 +1,250 @@
+package iblt
+
+import (
+    "math"
+)
+
+// the hash function counts CellCountFor chooses among
+var failureRateHashNums = []int{3, 4, 5}
+
+// Finite-size scaling of the peeling threshold, shifted and scaled as in Dembo
+// and Montanari, fitted to simulations of Table from 20 to 1000 items at failure
+// rates between 5e-2 and 1e-5: at load c = n/m decoding fails with probability
+// about 1 / (1 + exp(sqrt(n) * (threshold - c - beta * n^(-2/3)) / (alpha * c / threshold))),
+// the deviation is scaled by the load so that it vanishes in sparse tables.
+// Below the threshold the simulated rate falls exponentially with the load,
+// their Gaussian tail falls much faster and underestimates it at small rates
+var peelingScaling = map[int]struct{ alpha, beta float64 }{
+    3: {alpha: 0.180, beta: 1.56},
+    4: {alpha: 0.150, beta: 1.04},
+    5: {alpha: 0.135, beta: 0.80},
+}
+
+// fewest items peelingScaling was fitted to, fewer items are
+// bounded by counting their stopping sets instead
+const minFitItems = 20
+
+// In those simulations failures were up to about twice as frequent as estimated,
+// mostly where small stopping sets other than pairs dominate. Stopping sets are
+// counted for uniformly random cells, probing on collisions makes tables of a few
+// dozen cells fail up to a third more often than counted. Sizing aims this much lower
+const failureMargin = 2
+
+// Same as New, but sized so that decoding numItems fails with probability at most p
+func NewWithFailureRate(numItems uint, p float64) *Table {
+    ibltParam := GetIbltParamsForFailureRate(numItems, p)
+    return NewTable(CellCountFor(numItems, p), DEFAULT_DATA_BYTES, DEFAULT_HASH_BYTES, ibltParam.NumHashFuncs)
+}
+
+// Fewest cells, a multiple of the number of hash functions,
+// decoding numItems with failure probability at most p
+func CellCountFor(numItems uint, p float64) uint {
+    cells, _ := sizeFor(numItems, p)
+    return cells
+}
+
+// Same as GetIbltParams, but meeting failure probability p rather than the
+// rate ibltParamMap was simulated at, p is clamped to [1e-15, 1)
+func GetIbltParamsForFailureRate(numItems uint, p float64) IbltParam {
+    cells, hashNum := sizeFor(numItems, p)
+    if numItems == 0 {
+        return IbltParam{NumHashFuncs: hashNum, ItemOverhead: 0}
+    }
+    return IbltParam{NumHashFuncs: hashNum, ItemOverhead: float64(cells) / float64(numItems)}
+}
+
+func sizeFor(numItems uint, p float64) (uint, int) {
+    p = math.Max(math.Min(p, 1-1e-15), 1e-15)
+    bestCells, bestHash := uint(0), 0
+    for _, k := range failureRateHashNums {
+        step := uint(k)
+        if numItems == 0 {
+            return 0, k
+        }
+        // failure probability decreases with cells, gallop then bisect
+        lo, hi := uint(0), step
+        for failureProbability(numItems, hi, k) > p/failureMargin {
+            lo, hi = hi, 2*hi
+        }
+        for hi-lo > step {
+            mid := (lo + hi) / 2 / step * step
+            if failureProbability(numItems, mid, k) > p/failureMargin {
+                lo = mid
+            } else {
+                hi = mid
+            }
+        }
+        if bestHash == 0 || hi < bestCells {
+            bestCells, bestHash = hi, k
+        }
+    }
+    return bestCells, bestHash
+}
+
+// Estimated probability that n items in m cells with k hash functions do not
+// decode: the peeling process stalls near the threshold, or two items share
+// all their cells, the smallest stopping set. Fitted for 3 to 5 hash
+// functions, others borrow the nearest fit. Below minFitItems stopping sets are counted instead
+func failureProbability(n, m uint, k int) float64 {
+    if n == 0 {
+        return 0
+    }
+    if m < uint(k) || k < 1 {
+        return 1
+    }
+    if n < minFitItems {
+        return math.Min(1, stoppingSetProbability(n, m, k))
+    }
+
+    fit := k
+    if fit < 3 {
+        fit = 3
+    }
+    if fit > 5 {
+        fit = 5
+    }
+    scaling := peelingScaling[fit]
+    N := float64(n)
+    load := N / float64(m)
+    threshold := peelingThreshold(k)
+    z := math.Sqrt(N) * (threshold - load - scaling.beta*math.Pow(N, -2.0/3)) / (scaling.alpha * load / threshold)
+    stall := 1 / (1 + math.Exp(z))
+
+    // expected pairs of items on the same k cells, C(n, 2) / C(m, k)
+    pairs := 0.0
+    if n >= 2 {
+        pairs = math.Exp(lnChoose(N, 2) - lnChoose(float64(m), float64(k)))
+    }
+
+    return math.Min(1, stall+pairs)
+}
+
+// Expected number of stopping sets among n items in m cells with k hash
+// functions, sets of items covering each of their cells at least twice.
+// Peeling stalls exactly on such a set, so this bounds the failure probability.
+// dist[a*width+b] is the probability that s items cover a cells once
+// and b cells more often, the s items are a stopping set if a is 0
+func stoppingSetProbability(n, m uint, k int) float64 {
+    cells := uint(k) * n
+    if cells > m {
+        cells = m
+    }
+    width := int(cells) + 1
+    dist := make([]float64, width*width)
+    next := make([]float64, width*width)
+    dist[0] = 1
+    total := choose(float64(m), k)
+    expected := 0.0
+    for s := 1; s <= int(n); s++ {
+        for i := range next {
+            next[i] = 0
+        }
+        for a := 0; a < width; a++ {
+            for b := 0; a+b < width; b++ {
+                pr := dist[a*width+b]
+                if pr == 0 {
+                    continue
+                }
+                free := float64(m) - float64(a+b)
+                // i new cells, j cells covered once so far, the rest covered more often
+                for j := 0; j <= k && j <= a; j++ {
+                    for i := 0; i+j <= k; i++ {
+                        rest := k - i - j
+                        if rest > b {
+                            continue
+                        }
+                        w := choose(free, i) * choose(float64(a), j) * choose(float64(b), rest)
+                        if w == 0 {
+                            continue
+                        }
+                        next[(a+i-j)*width+b+j] += pr * w / total
+                    }
+                }
+            }
+        }
+        dist, next = next, dist
+
+        stuck := 0.0
+        for b := 1; b < width; b++ {
+            stuck += dist[b]
+        }
+        expected += math.Exp(lnChoose(float64(n), float64(s))) * stuck
+    }
+    return expected
+}
+
+// n choose k for small k, zero if k exceeds a whole n
+func choose(n float64, k int) float64 {
+    c := 1.0
+    for i := 0; i < k; i++ {
+        c *= (n - float64(i)) / float64(i+1)
+    }
+    return c
+}
+
+func lnChoose(n, k float64) float64 {
+    a, _ := math.Lgamma(n + 1)
+    b, _ := math.Lgamma(k + 1)
+    c, _ := math.Lgamma(n - k + 1)
+    return a - b - c
+}
+
+// Estimated probability that a table of bktNum buckets with hashNum hash
+// functions decodes diffs items, e.g. the estimated size of a set difference
+func DecodeSuccessProbability(bktNum uint, hashNum int, diffs uint) float64 {
+    return 1 - failureProbability(diffs, bktNum, hashNum)
+}
+
+// Number of items in the table, or differences after Subtract, as counted
+// by the buckets. A lower bound if items of both signs share buckets
+func (t Table) Size() uint {
+    total := uint(0)
+    for _, c := range t.counts {
+        if c < 0 {
+            c = -c
+        }
+        total += uint(c)
+    }
+    if t.HashNum < 1 {
+        return 0
+    }
+    return total / uint(t.HashNum)
+}
+
+// Items per bucket, the table decodes reliably below Capacity()/BktNum
+func (t Table) Load() float64 {
+    if t.BktNum == 0 {
+        return 0
+    }
+    return float64(t.Size()) / float64(t.BktNum)
+}
+
+// Most items a table of BktNum buckets is sized for by GetCellCount
+func (t Table) Capacity() uint {
+    // GetCellCount is not monotonic over the simulated item counts, scan
+    // them, beyond the largest one it grows with numItems, bisect there
+    capacity, head := uint(0), uint(0)
+    if len(ibltParamCounts) > 0 {
+        head = ibltParamCounts[len(ibltParamCounts)-1]
+    }
+    for n := uint(1); n <= head && n <= t.BktNum; n++ {
+        if GetCellCount(n) <= t.BktNum {
+            capacity = n
+        }
+    }
+
+    // every cell holds at most one item, so the answer is at most BktNum
+    lo, hi := head, t.BktNum
+    for lo < hi {
+        mid := lo + (hi-lo+1)/2
+        if GetCellCount(mid) <= t.BktNum {
+            lo = mid
+        } else {
+            hi = mid - 1
+        }
+    }
+    if lo > head {
+        capacity = lo
+    }
+    return capacity
+}
@@ -0,0 +1,135 @@
+package iblt
+
+import (
+    "math/rand"
+    "testing"
+)
+
+func TestCellCountFor(t *testing.T) {
+    for _, n := range []uint{1, 10, 100, 1000, 100000} {
+        prev := uint(0)
+        for _, p := range []float64{0.1, 0.01, 1.0 / 240, 1.0 / 24000, 1e-9} {
+            cells := CellCountFor(n, p)
+            param := GetIbltParamsForFailureRate(n, p)
+            if cells < prev {
+                t.Errorf("lower failure rate should not need fewer cells, %d items, p %v", n, p)
+            }
+            if cells % uint(param.NumHashFuncs) != 0 {
+                t.Errorf("cell count %d should be a multiple of %d", cells, param.NumHashFuncs)
+            }
+            if failureProbability(n, cells, param.NumHashFuncs) > p {
+                t.Errorf("%d cells should meet failure rate %v for %d items", cells, p, n)
+            }
+            if float64(cells) < float64(n) / peelingThreshold(param.NumHashFuncs) {
+                t.Errorf("%d cells is below the peeling threshold for %d items", cells, n)
+            }
+            prev = cells
+        }
+    }
+    if CellCountFor(0, 0.01) != 0 {
+        t.Error("no items should need no cells")
+    }
+}
+
+func TestNewWithFailureRate(t *testing.T) {
+    // enough trials that a table failing twice as often as p is caught,
+    // sized tables fail well below p, so no slack is needed
+    for _, test := range []struct {
+        numItems uint
+        p        float64
+        trials   int
+    }{
+        {100, 0.01, 1000},
+        {100, 1e-3, 40000},
+        // below the fitted range
+        {6, 0.1, 2000},
+        {8, 0.01, 10000},
+        {12, 1e-3, 40000},
+        {15, 1e-3, 40000},
+    } {
+        // sizing is deterministic, copy an empty table rather than size it every trial
+        empty := NewWithFailureRate(test.numItems, test.p)
+        failures := 0
+        b := make([]byte, DEFAULT_DATA_BYTES)
+        for i := 0; i < test.trials; i ++ {
+            table := empty.Copy()
+            for j := uint(0); j < test.numItems; j ++ {
+                rand.Read(b)
+                table.Insert(b)
+            }
+            diff, err := table.Decode()
+            if err != nil || uint(diff.AlphaLen()) != test.numItems {
+                failures++
+            }
+        }
+        if failures > int(test.p * float64(test.trials)) {
+            t.Errorf("failure rate want at most %v, get %d of %d", test.p, failures, test.trials)
+        }
+    }
+}
+
+func TestFailureProbability(t *testing.T) {
+    prev := 1.0
+    for m := uint(100); m <= 400; m += 4 {
+        p := failureProbability(100, m, 4)
+        if p < 0 || p > prev {
+            t.Errorf("failure probability should decrease with cells, get %v at %d", p, m)
+        }
+        prev = p
+    }
+    if failureProbability(100, 3, 4) != 1 || failureProbability(0, 10, 4) != 0 {
+        t.Error("failure probability of degenerate tables")
+    }
+}
+
+func TestDecodeSuccessProbability(t *testing.T) {
+    table := New(1000)
+    if p := DecodeSuccessProbability(table.BktNum, table.HashNum, 1000); p < 0.99 || p > 1 {
+        t.Errorf("table sized for 1000 items should decode them, get probability %v", p)
+    }
+    if p := DecodeSuccessProbability(table.BktNum, table.HashNum, 1100); p > 0.01 {
+        t.Errorf("beyond the peeling threshold decoding should fail, get probability %v", p)
+    }
+    if p := DecodeSuccessProbability(table.BktNum, table.HashNum, 0); p != 1 {
+        t.Errorf("empty difference should always decode, get probability %v", p)
+    }
+}
+
+func TestTableLoad(t *testing.T) {
+    numItems := uint(500)
+    // across the simulated counts and the extrapolated ones beyond
+    for n := uint(1); n <= 1200; n ++ {
+        if c := New(n).Capacity(); c < n {
+            t.Errorf("capacity of New(%d) want at least %d, get %d", n, n, c)
+        }
+    }
+    if c := NewTable(0, 6, 3, 4).Capacity(); c != 0 {
+        t.Errorf("capacity of an empty table want 0, get %d", c)
+    }
+    if s := NewTable(80, 6, 3, 0).Size(); s != 0 {
+        t.Errorf("size without hash functions want 0, get %d", s)
+    }
+
+    table := New(numItems)
+    b := make([]byte, DEFAULT_DATA_BYTES)
+    for i := uint(0); i < numItems; i ++ {
+        rand.Read(b)
+        table.Insert(b)
+    }
+    if table.Size() != numItems {
+        t.Errorf("size want %d, get %d", numItems, table.Size())
+    }
+    if table.Load() != float64(numItems) / float64(table.BktNum) {
+        t.Errorf("load want %v, get %v", float64(numItems) / float64(table.BktNum), table.Load())
+    }
+
+    other := New(numItems)
+    for i := 0; i < 10; i ++ {
+        rand.Read(b)
+        other.Insert(b)
+    }
+    other.Subtract(table)
+    if other.Size() > numItems + 10 {
+        t.Errorf("size of a difference should be at most %d, get %d", numItems + 10, other.Size())
+    }
+}
@@ -0,0 +1,185 @@
+package iblt
+
+import (
+    "bytes"
+    "encoding/binary"
+    "fmt"
+    "github.com/dchest/siphash"
+    "io"
+    "math/bits"
+)
+
+var DEFAULT_STRATA_NUM = 32
+var DEFAULT_STRATA_BUCKETS uint = 80
+var DEFAULT_STRATA_HASH_NUM = 4
+
+// hash has 64 bits, there are no more strata to reach
+const maxStrataNum = 64
+
+// Strata estimator from "What's the Difference? Efficient Set Reconciliation without Prior Context",
+// an item goes to stratum i with probability 1/2^(i+1), each stratum is a small IBLT.
+// Peers exchange estimators first to learn the size of their set difference,
+// then size the real table with New.
+type StrataEstimator struct {
+    Strata []*Table
+}
+
+func NewStrataEstimator(dataLen int) *StrataEstimator {
+    return newStrataEstimator(DEFAULT_STRATA_NUM, DEFAULT_STRATA_BUCKETS, dataLen,
+        DEFAULT_HASH_BYTES, DEFAULT_STRATA_HASH_NUM, DEFAULT_KEY)
+}
+
+// Specify number of strata, 1 to 64, and the parameters of each stratum as in NewTableWithKey
+func NewStrataEstimatorWithParams(strataNum int, buckets uint, dataLen int, hashLen int, hashNum int, key SipKey) (*StrataEstimator, error) {
+    if !validStrataNum(strataNum) {
+        return nil, fmt.Errorf("%w: number of strata %d", ErrBadParams, strataNum)
+    }
+    return newStrataEstimator(strataNum, buckets, dataLen, hashLen, hashNum, key), nil
+}
+
+func newStrataEstimator(strataNum int, buckets uint, dataLen int, hashLen int, hashNum int, key SipKey) *StrataEstimator {
+    if strataNum < 0 {
+        strataNum = 0
+    }
+    strata := make([]*Table, strataNum)
+    for i := range strata {
+        strata[i] = NewTableWithKey(buckets, dataLen, hashLen, hashNum, key)
+    }
+    return &StrataEstimator{Strata: strata}
+}
+
+func validStrataNum(strataNum int) bool {
+    return strataNum >= 1 && strataNum <= maxStrataNum
+}
+
+func (s *StrataEstimator) Insert(d []byte) error {
+    if !validStrataNum(len(s.Strata)) {
+        return fmt.Errorf("%w: number of strata %d", ErrBadParams, len(s.Strata))
+    }
+    return s.Strata[s.stratum(d)].Insert(d)
+}
+
+func (s *StrataEstimator) Delete(d []byte) error {
+    if !validStrataNum(len(s.Strata)) {
+        return fmt.Errorf("%w: number of strata %d", ErrBadParams, len(s.Strata))
+    }
+    return s.Strata[s.stratum(d)].Delete(d)
+}
+
+// number of trailing zeros of the item hash, a hash independent of
+// bucket indexing since the strata share the same key
+func (s StrataEstimator) stratum(d []byte) int {
+    key := s.Strata[0].Key
+    h := siphash.Hash(key.K0, ^key.K1, d)
+    i := bits.TrailingZeros64(h)
+    if i >= len(s.Strata) {
+        i = len(s.Strata) - 1
+    }
+    return i
+}
+
+// Modify callee, s = s - a
+func (s *StrataEstimator) Subtract(a *StrataEstimator) error {
+    if len(s.Strata) != len(a.Strata) {
+        return fmt.Errorf("%w: number of strata %d, %d", ErrParamMismatch, len(s.Strata), len(a.Strata))
+    }
+    for i := range s.Strata {
+        if err := s.Strata[i].Subtract(a.Strata[i]); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (s StrataEstimator) Copy() *StrataEstimator {
+    strata := make([]*Table, len(s.Strata))
+    for i, stratum := range s.Strata {
+        strata[i] = stratum.Copy()
+    }
+    return &StrataEstimator{Strata: strata}
+}
+
+// Estimated size of the set difference, call after Subtract.
+// Strata are decoded from the top down until one fails
+// and the count so far is scaled up accordingly.
+func (s StrataEstimator) Estimate() uint {
+    count, _ := s.estimate()
+    return count
+}
+
+// also reports whether every stratum decoded, i.e. the count is exact
+func (s StrataEstimator) estimate() (uint, bool) {
+    count := uint(0)
+    for i := len(s.Strata) - 1; i >= 0; i-- {
+        diff, err := s.Strata[i].DecodeCopy()
+        if err != nil {
+            return count << uint(i+1), false
+        }
+        count += uint(diff.AlphaLen() + diff.BetaLen())
+    }
+    return count, true
+}
+
+func (s StrataEstimator) Serialize() ([]byte, error) {
+    var buffer bytes.Buffer
+    if _, err := s.WriteTo(&buffer); err != nil {
+        return nil, err
+    }
+    return buffer.Bytes(), nil
+}
+
+func DeserializeStrataEstimator(b []byte) (*StrataEstimator, error) {
+    reader := bytes.NewReader(b)
+    s, err := ReadStrataEstimator(reader)
+    if err != nil {
+        return nil, err
+    }
+    if reader.Len() != 0 {
+        return nil, fmt.Errorf("%w: trailing bytes after strata estimator", ErrMalformed)
+    }
+    return s, nil
+}
+
+// Serialize layout: number of strata (uvarint) followed by every stratum as a serialized table
+func (s StrataEstimator) WriteTo(w io.Writer) (int64, error) {
+    if !validStrataNum(len(s.Strata)) {
+        return 0, fmt.Errorf("%w: number of strata %d", ErrBadParams, len(s.Strata))
+    }
+    varBytes := make([]byte, binary.MaxVarintLen64)
+    n := binary.PutUvarint(varBytes, uint64(len(s.Strata)))
+    written, err := w.Write(varBytes[:n])
+    total := int64(written)
+    if err != nil {
+        return total, err
+    }
+    for _, stratum := range s.Strata {
+        written, err := stratum.WriteTo(w)
+        total += written
+        if err != nil {
+            return total, err
+        }
+    }
+    return total, nil
+}
+
+func ReadStrataEstimator(r io.Reader) (*StrataEstimator, error) {
+    tr := &tableReader{r: r}
+    strataNum, err := binary.ReadUvarint(tr)
+    if err != nil {
+        return nil, fmt.Errorf("%w: truncated number of strata: %v", ErrMalformed, err)
+    }
+    if strataNum > maxStrataNum || !validStrataNum(int(strataNum)) {
+        return nil, fmt.Errorf("%w: illegal number of strata %d", ErrMalformed, strataNum)
+    }
+
+    strata := make([]*Table, strataNum)
+    for i := range strata {
+        if strata[i], err = ReadTable(r); err != nil {
+            return nil, err
+        }
+        if err = strata[i].check(strata[0]); err != nil {
+            return nil, fmt.Errorf("%w: stratum %d: %v", ErrMalformed, i, err)
+        }
+    }
+    return &StrataEstimator{Strata: strata}, nil
+}
@@ -0,0 +1,85 @@
+package iblt
+
+import (
+    "errors"
+    "math/rand"
+    "testing"
+)
+
+func TestStrataEstimator(t *testing.T) {
+    var diffs = []int{0, 10, 100, 1000, 10000}
+    sharedItems := 5000
+
+    for _, d := range diffs {
+        alpha := NewStrataEstimator(6)
+        beta := NewStrataEstimator(6)
+        b := make([]byte, 6)
+        for i := 0; i < sharedItems; i ++ {
+            rand.Read(b)
+            if err := alpha.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+            if err := beta.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+        // split difference between both sides
+        for i := 0; i < d; i ++ {
+            rand.Read(b)
+            side := alpha
+            if i % 2 == 0 {
+                side = beta
+            }
+            if err := side.Insert(b); err != nil {
+                t.Errorf("insert failed error: %v", err)
+            }
+        }
+
+        enc, err := beta.Serialize()
+        if err != nil {
+            t.Errorf("estimator serialize error %v", err)
+        }
+        rec, err := DeserializeStrataEstimator(enc)
+        if err != nil {
+            t.Errorf("estimator deserialize error %v", err)
+        }
+        if err := alpha.Subtract(rec); err != nil {
+            t.Errorf("subtract error: %v", err)
+        }
+
+        est := alpha.Estimate()
+        if est < uint(d/2) || est > uint(2*d) {
+            t.Errorf("estimated difference off by more than a factor of 2, want %d, get %d", d, est)
+        }
+    }
+}
+
+func TestStrataEstimatorWithParams(t *testing.T) {
+    for _, strataNum := range []int{-1, 0, maxStrataNum + 1} {
+        if _, err := NewStrataEstimatorWithParams(strataNum, 80, 6, 3, 4, DEFAULT_KEY); !errors.Is(err, ErrBadParams) {
+            t.Errorf("%d strata want ErrBadParams, get %v", strataNum, err)
+        }
+    }
+    if err := (&StrataEstimator{}).Insert(make([]byte, 6)); !errors.Is(err, ErrBadParams) {
+        t.Errorf("insert without strata want ErrBadParams, get %v", err)
+    }
+
+    // every accepted estimator must survive a round trip
+    for _, strataNum := range []int{1, maxStrataNum} {
+        s, err := NewStrataEstimatorWithParams(strataNum, 80, 6, 3, 4, DEFAULT_KEY)
+        if err != nil {
+            t.Errorf("%d strata error: %v", strataNum, err)
+            continue
+        }
+        if err := s.Insert(make([]byte, 6)); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+        enc, err := s.Serialize()
+        if err != nil {
+            t.Errorf("estimator serialize error %v", err)
+        }
+        if _, err := DeserializeStrataEstimator(enc); err != nil {
+            t.Errorf("%d strata deserialize error %v", strataNum, err)
+        }
+    }
+}
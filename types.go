@@ -3,7 +3,6 @@ package iblt
 import (
     "bytes"
     "encoding/binary"
-    "errors"
     "fmt"
     "github.com/dchest/siphash"
     "github.com/seiflotfy/cuckoofilter"
@@ -14,11 +13,15 @@ const (
     key1 = 629
 )
 
-func sipHash(b []byte) []byte {
-    // TODO: key constants
-    h := siphash.Hash(key0, key1, b)
-    rtn := make([]byte, 8)
-    binary.BigEndian.PutUint64(rtn, h)
+// 128-bit SipHash key used for both bucket indexing and hash checksums
+type SipKey struct {
+    K0 uint64
+    K1 uint64
+}
+
+func (k SipKey) sipHash(b []byte) [8]byte {
+    var rtn [8]byte
+    binary.BigEndian.PutUint64(rtn[:], siphash.Hash(k.K0, k.K1, b))
     return rtn
 }
 
@@ -62,39 +65,10 @@ func NewBucket(dataLen, hashLen int) *Bucket {
     }
 }
 
-func (b *Bucket) xor(a *Bucket) {
-    xor(b.dataSum, a.dataSum)
-    xor(b.hashSum, a.hashSum)
-}
-
-func (b *Bucket) subtract(a *Bucket) {
-    b.xor(a)
-    b.count = b.count - a.count
-}
-
-func (b *Bucket) operate(d []byte, sign bool) {
-    xor(b.dataSum, d)
-    h := sipHash(d)
-    xor(b.hashSum, h)
-    if sign {
-        b.count++
-    } else {
-        b.count--
-    }
-}
-
-func (b Bucket) copy() *Bucket {
-    bkt := NewBucket(len(b.dataSum), len(b.hashSum))
-    copy(bkt.dataSum, b.dataSum)
-    bkt.hashSum = b.hashSum
-    bkt.count = b.count
-    return bkt
-}
-
-func (b Bucket) pure() bool {
+func (b Bucket) pure(key SipKey) bool {
     if b.count == 1 || b.count == -1 {
-        h := sipHash(b.dataSum)
-        if equalPrefix(b.hashSum, h) {
+        h := key.sipHash(b.dataSum)
+        if equalPrefix(b.hashSum, h[:]) {
             return true
         }
     }
@@ -192,14 +166,14 @@ func (d *Diff) encode(b *Bucket) error {
     if b.count == 1 {
         if d.Beta.test(cpy) {
             d.Beta.delete(cpy)
-            return errors.New("repetitive bytes found in beta")
+            return fmt.Errorf("%w: found in beta", ErrDuplicateInDiff)
         }
         d.Alpha.insert(cpy)
     }
     if b.count == -1 {
         if d.Alpha.test(cpy) {
             d.Alpha.delete(cpy)
-            return errors.New("repetitive bytes found in alpha")
+            return fmt.Errorf("%w: found in alpha", ErrDuplicateInDiff)
         }
         d.Beta.insert(cpy)
     }
@@ -0,0 +1,62 @@
+package iblt
+
+import (
+    "encoding/binary"
+    "fmt"
+)
+
+// Same as New, but items could be of any length up to maxLen
+func NewVariable(numItems uint, maxLen int) *Table {
+    ibltParam := GetIbltParams(numItems)
+    numCells := GetCellCount(numItems)
+
+    return NewVariableTable(numCells, maxLen, DEFAULT_HASH_BYTES, ibltParam.NumHashFuncs)
+}
+
+// Same as NewTable, but items could be of any length up to maxLen,
+// DataLen is maxLen plus the length prefix
+func NewVariableTable(buckets uint, maxLen int, hashLen int, hashNum int) *Table {
+    table := NewTable(buckets, maxLen+uvarintLen(uint64(maxLen)), hashLen, hashNum)
+    table.VariableLen = true
+    return table
+}
+
+// longest item that could be inserted
+func (t Table) MaxItemLen() int {
+    if !t.VariableLen {
+        return t.DataLen
+    }
+    n := t.DataLen - 1
+    for n > 0 && n+uvarintLen(uint64(n)) > t.DataLen {
+        n--
+    }
+    return n
+}
+
+// d as stored in buckets, padded to DataLen in variable length mode
+func (t Table) item(d []byte) ([]byte, error) {
+    if !t.VariableLen {
+        return d, nil
+    }
+    if maxLen := t.MaxItemLen(); len(d) > maxLen {
+        return nil, fmt.Errorf("%w: want at most %d, get %d", ErrDataLength, maxLen, len(d))
+    }
+    cell := make([]byte, t.DataLen)
+    n := binary.PutUvarint(cell, uint64(len(d)))
+    copy(cell[n:], d)
+    return cell, nil
+}
+
+// inverse of item, the result aliases cell,
+// false if cell is not a canonical prefix, item and zero padding
+func (t Table) unpad(cell []byte) ([]byte, bool) {
+    l, n := binary.Uvarint(cell)
+    if n <= 0 || n != uvarintLen(l) || l > uint64(len(cell)-n) {
+        return nil, false
+    }
+    d := cell[n : n+int(l)]
+    if !empty(cell[n+int(l):]) {
+        return nil, false
+    }
+    return d, true
+}
@@ -0,0 +1,120 @@
+package iblt
+
+import (
+    "errors"
+    "math/rand"
+    "reflect"
+    "testing"
+)
+
+// n items of random length up to maxLen, none of them already in seen,
+// which collects them, so that items of different sets never cancel out
+func randomVariableItems(n, maxLen int, seen map[string]bool) [][]byte {
+    items := make([][]byte, 0, n)
+    for len(items) < n {
+        d := make([]byte, rand.Intn(maxLen+1))
+        rand.Read(d)
+        if seen[string(d)] {
+            continue
+        }
+        seen[string(d)] = true
+        items = append(items, d)
+    }
+    return items
+}
+
+func TestVariableTable(t *testing.T) {
+    maxLen := 200
+    alpha := NewVariable(40, maxLen)
+    beta := NewVariable(40, maxLen)
+    seen := make(map[string]bool)
+    // both lengths at the edge, on alpha's side only
+    alphaItems := [][]byte{{}, make([]byte, maxLen)}
+    rand.Read(alphaItems[1])
+    for _, d := range alphaItems {
+        seen[string(d)] = true
+    }
+    alphaItems = append(alphaItems, randomVariableItems(18, maxLen, seen)...)
+    betaItems := randomVariableItems(18, maxLen, seen)
+    for _, d := range alphaItems {
+        if err := alpha.Insert(d); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    for _, d := range betaItems {
+        if err := beta.Insert(d); err != nil {
+            t.Errorf("insert failed error: %v", err)
+        }
+    }
+    for _, d := range randomVariableItems(298, maxLen, seen) {
+        alpha.Insert(d)
+        beta.Insert(d)
+    }
+
+    if err := alpha.Subtract(beta); err != nil {
+        t.Errorf("subtract failed error: %v", err)
+    }
+    diff, err := alpha.Decode()
+    if err != nil {
+        t.Errorf("decode failed error: %v", err)
+    }
+    if !reflect.DeepEqual(BytesArrayToSortedString(diff.AlphaSlice()), BytesArrayToSortedString(alphaItems)) {
+        t.Error("alpha items not recovered unpadded")
+    }
+    if !reflect.DeepEqual(BytesArrayToSortedString(diff.BetaSlice()), BytesArrayToSortedString(betaItems)) {
+        t.Error("beta items not recovered unpadded")
+    }
+
+    if err := beta.Insert(make([]byte, maxLen+1)); !errors.Is(err, ErrDataLength) {
+        t.Errorf("item longer than max length should fail with ErrDataLength, get %v", err)
+    }
+    if err := beta.Subtract(NewTable(beta.BktNum, beta.DataLen, beta.HashLen, beta.HashNum)); !errors.Is(err, ErrParamMismatch) {
+        t.Errorf("subtract of fixed length table should fail with ErrParamMismatch, get %v", err)
+    }
+}
+
+func TestVariableTable_Serialize(t *testing.T) {
+    table := NewVariable(50, 100)
+    for _, d := range randomVariableItems(50, 100, make(map[string]bool)) {
+        table.Insert(d)
+    }
+    enc, err := table.Serialize()
+    if err != nil {
+        t.Errorf("serialize failed error: %v", err)
+    }
+    rec, err := Deserialize(enc)
+    if err != nil {
+        t.Errorf("deserialize failed error: %v", err)
+    }
+    if !reflect.DeepEqual(rec, table) {
+        t.Error("deserialized variable length table not equal")
+    }
+}
+
+func TestMaxItemLen(t *testing.T) {
+    for _, maxLen := range []int{0, 1, 127, 128, 129, 16383, 16384} {
+        table := NewVariableTable(8, maxLen, 1, 4)
+        if table.MaxItemLen() != maxLen {
+            t.Errorf("max item length want %d, get %d", maxLen, table.MaxItemLen())
+        }
+    }
+    if New(10).MaxItemLen() != DEFAULT_DATA_BYTES {
+        t.Errorf("fixed length table max item length should be DataLen")
+    }
+}
+
+func TestUnpad(t *testing.T) {
+    table := NewVariableTable(8, 10, 1, 4)
+    for _, cell := range [][]byte{
+        // length beyond the cell
+        {11, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+        // non-zero padding
+        {1, 7, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+        // non-canonical length prefix
+        {0x81, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0},
+    } {
+        if _, ok := table.unpad(cell); ok {
+            t.Errorf("illegal cell should not unpad %v", cell)
+        }
+    }
+}